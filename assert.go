@@ -0,0 +1,160 @@
+package testy
+
+import (
+	"time"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+// True passes if x == true, and otherwise stops the test. See [assert.True].
+func (a Assert) True(x bool) {
+	a.t.Helper()
+	assert.True(a.t, x)
+}
+
+// False passes if x == false, and otherwise stops the test. See [assert.False].
+func (a Assert) False(x bool) {
+	a.t.Helper()
+	assert.False(a.t, x)
+}
+
+// Error passes if err != nil, and otherwise stops the test. See [assert.Error].
+func (a Assert) Error(err error) {
+	a.t.Helper()
+	assert.Error(a.t, err)
+}
+
+// NoError passes if err == nil, and otherwise stops the test. See
+// [assert.NoError].
+func (a Assert) NoError(err error) {
+	a.t.Helper()
+	assert.NoError(a.t, err)
+}
+
+// ErrorIs passes if errors.Is(err, target), and otherwise stops the test. See
+// [assert.ErrorIs].
+func (a Assert) ErrorIs(err error, target error) {
+	a.t.Helper()
+	assert.ErrorIs(a.t, err, target)
+}
+
+// ErrorContains passes if err != nil and err.Error() contains substring, and
+// otherwise stops the test. See [assert.ErrorContains].
+func (a Assert) ErrorContains(err error, substring string) {
+	a.t.Helper()
+	assert.ErrorContains(a.t, err, substring)
+}
+
+// Nil passes if val == nil, and otherwise stops the test. See [assert.Nil].
+func (a Assert) Nil(val any) {
+	a.t.Helper()
+	assert.Nil(a.t, val)
+}
+
+// NotNil passes if val != nil, and otherwise stops the test. See
+// [assert.NotNil].
+func (a Assert) NotNil(val any) {
+	a.t.Helper()
+	assert.NotNil(a.t, val)
+}
+
+// Empty passes if val has a length of zero, and otherwise stops the test. See
+// [assert.Empty].
+func (a Assert) Empty(val any) {
+	a.t.Helper()
+	assert.Empty(a.t, val)
+}
+
+// NotEmpty passes if val has a length greater than zero, and otherwise stops
+// the test. See [assert.NotEmpty].
+func (a Assert) NotEmpty(val any) {
+	a.t.Helper()
+	assert.NotEmpty(a.t, val)
+}
+
+// Eventually passes if condition returns true within timeout, and otherwise
+// stops the test. See [assert.Eventually].
+func (a Assert) Eventually(condition func() bool, timeout time.Duration, interval time.Duration) {
+	a.t.Helper()
+	assert.Eventually(a.t, condition, timeout, interval)
+}
+
+// EventuallyWith passes if an attempt of fn passes within timeout, and
+// otherwise stops the test. See [assert.EventuallyWith].
+func (a Assert) EventuallyWith(fn func(t common.T), timeout time.Duration, interval time.Duration) {
+	a.t.Helper()
+	assert.EventuallyWith(a.t, fn, timeout, interval)
+}
+
+// Consistently passes if condition returns true for all of duration, and
+// otherwise stops the test. See [assert.Consistently].
+func (a Assert) Consistently(condition func() bool, duration time.Duration, interval time.Duration) {
+	a.t.Helper()
+	assert.Consistently(a.t, condition, duration, interval)
+}
+
+// ConsistentlyWith passes if every attempt of fn passes for all of duration,
+// and otherwise stops the test. See [assert.ConsistentlyWith].
+func (a Assert) ConsistentlyWith(fn func(t common.T), duration time.Duration, interval time.Duration) {
+	a.t.Helper()
+	assert.ConsistentlyWith(a.t, fn, duration, interval)
+}
+
+// JSONEqual passes if want and got are equivalent JSON documents, and otherwise
+// stops the test. See [assert.JSONEqual].
+func (a Assert) JSONEqual(want []byte, got []byte, opts ...check.JSONOption) {
+	a.t.Helper()
+	assert.JSONEqual(a.t, want, got, opts...)
+}
+
+// Panics passes if fn panics, and otherwise stops the test. See
+// [assert.Panics].
+func (a Assert) Panics(fn func()) {
+	a.t.Helper()
+	assert.Panics(a.t, fn)
+}
+
+// NotPanics passes if fn does not panic, and otherwise stops the test. See
+// [assert.NotPanics].
+func (a Assert) NotPanics(fn func()) {
+	a.t.Helper()
+	assert.NotPanics(a.t, fn)
+}
+
+// WithinDuration passes if want and got are at most d apart, and otherwise
+// stops the test. See [assert.WithinDuration].
+func (a Assert) WithinDuration(want time.Time, got time.Time, d time.Duration) {
+	a.t.Helper()
+	assert.WithinDuration(a.t, want, got, d)
+}
+
+// TimeEqual passes if want and got are the same instant, and otherwise stops
+// the test. See [assert.TimeEqual].
+func (a Assert) TimeEqual(want time.Time, got time.Time) {
+	a.t.Helper()
+	assert.TimeEqual(a.t, want, got)
+}
+
+// NoFailures stops the test if it has already failed, and then calls each of
+// thunks, stopping the test as soon as one of them fails it. See
+// [assert.NoFailures].
+func (a Assert) NoFailures(thunks ...func()) {
+	a.t.Helper()
+	assert.NoFailures(a.t, thunks...)
+}
+
+// NoErrors stops the test if it has already failed, and then calls each of
+// thunks, stopping the test as soon as one of them fails it or returns an
+// error. See [assert.NoErrors].
+func (a Assert) NoErrors(thunks ...func() error) {
+	a.t.Helper()
+	assert.NoErrors(a.t, thunks...)
+}
+
+// Go calls fn on a new goroutine and returns a group that stops the test if it
+// failed when Wait is called. See [assert.Go].
+func (a Assert) Go(fn func(t common.T)) *assert.Group {
+	return assert.Go(a.t, fn)
+}
@@ -121,6 +121,42 @@ func NoError(t common.T, err error) {
 	}
 }
 
+// ErrorIs passes if errors.Is(err, target).
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes the full tree of errors wrapped by err.
+func ErrorIs(t common.T, err error, target error) {
+	t.Helper()
+	if !check.ErrorIs(t, err, target) {
+		t.FailNow()
+	}
+}
+
+// ErrorAs passes and returns the first error in err's tree that matches the
+// type E, if errors.As(err, &target).
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes the full tree of errors wrapped by err.
+func ErrorAs[E error](t common.T, err error) E {
+	t.Helper()
+	target, ok := check.ErrorAs[E](t, err)
+	if !ok {
+		t.FailNow()
+	}
+	return target
+}
+
+// ErrorContains passes if err != nil and err.Error() contains substring.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes the full tree of errors wrapped by err.
+func ErrorContains(t common.T, err error, substring string) {
+	t.Helper()
+	if !check.ErrorContains(t, err, substring) {
+		t.FailNow()
+	}
+}
+
 // In passes if want is an element of slice.
 //
 // Otherwise, the test is immediately failed and stopped with t.FailNow().
@@ -147,6 +183,67 @@ func NotIn[Type any](t common.T, want Type, slice []Type, opts ...gocmp.Option)
 	}
 }
 
+// ElementsMatch passes if want and got contain the same elements, the same
+// number of times, in any order.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes the elements of want that are missing from got,
+// and the extra elements in got that are not in want.
+//
+// Elements are compared using go-cmp, not ==. You can change the behavior of
+// the equality checking using the go-cmp/cmp Options system. For more
+// information, see [the go-cmp documentation](https://pkg.go.dev/github.com/google/go-cmp/cmp#Equal).
+func ElementsMatch[Type any](t common.T, want []Type, got []Type, opts ...gocmp.Option) {
+	t.Helper()
+	if !check.ElementsMatch(t, want, got, opts...) {
+		t.FailNow()
+	}
+}
+
+// Subset passes if every element of got is also an element of want.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes the elements of got that are not in want.
+//
+// You can change the behavior of the equality checking using the go-cmp/cmp
+// Options system. For more information, see [the go-cmp documentation](https://pkg.go.dev/github.com/google/go-cmp/cmp#Equal).
+func Subset[Type any](t common.T, want []Type, got []Type, opts ...gocmp.Option) {
+	t.Helper()
+	if !check.Subset(t, want, got, opts...) {
+		t.FailNow()
+	}
+}
+
+// Superset passes if every element of want is also an element of got.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes the elements of want that are missing from got.
+//
+// You can change the behavior of the equality checking using the go-cmp/cmp
+// Options system. For more information, see [the go-cmp documentation](https://pkg.go.dev/github.com/google/go-cmp/cmp#Equal).
+func Superset[Type any](t common.T, want []Type, got []Type, opts ...gocmp.Option) {
+	t.Helper()
+	if !check.Superset(t, want, got, opts...) {
+		t.FailNow()
+	}
+}
+
+// MapContains passes if every key in want is also a key in got, with an equal
+// value.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message names each key that is missing from got or that has a
+// different value.
+//
+// You can change the behavior of the equality checking using the go-cmp/cmp
+// Options system. For more information, see [the go-cmp documentation](https://pkg.go.dev/github.com/google/go-cmp/cmp#Equal).
+func MapContains[Key comparable, Value any](t common.T, want map[Key]Value, got map[Key]Value, opts ...gocmp.Option) {
+	t.Helper()
+	if !check.MapContains(t, want, got, opts...) {
+		t.FailNow()
+	}
+}
+
 // Nil passes if val == nil.
 //
 // Otherwise, the test is immediately failed and stopped with t.FailNow().
@@ -190,3 +287,71 @@ func NotNil(t common.T, val any) {
 		t.FailNow()
 	}
 }
+
+// Zero passes if val is the zero value of its type.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// Uses reflection so that types that are not comparable, like structs with
+// slice fields, can still be checked.
+func Zero[Type any](t common.T, val Type) {
+	t.Helper()
+	if !check.Zero(t, val) {
+		t.FailNow()
+	}
+}
+
+// NotZero passes if val is not the zero value of its type.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// Uses reflection so that types that are not comparable, like structs with
+// slice fields, can still be checked.
+func NotZero[Type any](t common.T, val Type) {
+	t.Helper()
+	if !check.NotZero(t, val) {
+		t.FailNow()
+	}
+}
+
+// Empty passes if val has no elements.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// Uses reflection because Go doesn't have a type constraint for "has a
+// length". Supports the following types, and fails for any other type:
+//
+//   - nil
+//   - slice
+//   - map
+//   - string
+//   - channel
+//   - array
+//   - iter.Seq and iter.Seq2
+func Empty(t common.T, val any) {
+	t.Helper()
+	if !check.Empty(t, val) {
+		t.FailNow()
+	}
+}
+
+// NotEmpty passes if val has at least one element.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// Uses reflection because Go doesn't have a type constraint for "has a
+// length". Supports the following types, and fails for any other type:
+//
+//   - nil
+//   - slice
+//   - map
+//   - string
+//   - channel
+//   - array
+//   - iter.Seq and iter.Seq2
+func NotEmpty(t common.T, val any) {
+	t.Helper()
+	if !check.NotEmpty(t, val) {
+		t.FailNow()
+	}
+}
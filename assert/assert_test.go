@@ -1,6 +1,7 @@
 package assert_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -393,6 +394,47 @@ func TestError(t *testing.T) {
 	})
 }
 
+var errNotFound = errors.New("not found")
+
+type codeError struct {
+	Code int
+}
+
+func (e *codeError) Error() string {
+	return fmt.Sprintf("code %d", e.Code)
+}
+
+func TestErrorIs(t *testing.T) {
+	t.Parallel()
+	assert.ErrorIs(t, fmt.Errorf("loading user: %w", errNotFound), errNotFound)
+
+	mt := &common.MockT{}
+	assert.ErrorIs(mt, errors.New("not found"), errNotFound)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestErrorAs(t *testing.T) {
+	t.Parallel()
+	target := assert.ErrorAs[*codeError](t, fmt.Errorf("request failed: %w", &codeError{Code: 404}))
+	assert.Equal(t, 404, target.Code)
+
+	mt := &common.MockT{}
+	assert.ErrorAs[*codeError](mt, errNotFound)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestErrorContains(t *testing.T) {
+	t.Parallel()
+	assert.ErrorContains(t, fmt.Errorf("loading user: %w", errNotFound), "not found")
+
+	mt := &common.MockT{}
+	assert.ErrorContains(mt, errNotFound, "timeout")
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
 func TestIn(t *testing.T) {
 	t.Parallel()
 	t.Run("int", func(t *testing.T) {
@@ -563,6 +605,46 @@ func TestNotIn(t *testing.T) {
 	})
 }
 
+func TestElementsMatch(t *testing.T) {
+	t.Parallel()
+	assert.ElementsMatch(t, []int{1, 2, 3}, []int{3, 1, 2})
+
+	mt := &common.MockT{}
+	assert.ElementsMatch(mt, []int{1, 2, 3}, []int{1, 2, 2})
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestSubset(t *testing.T) {
+	t.Parallel()
+	assert.Subset(t, []int{1, 2, 3}, []int{3, 1})
+
+	mt := &common.MockT{}
+	assert.Subset(mt, []int{1, 2, 3}, []int{1, 4})
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestSuperset(t *testing.T) {
+	t.Parallel()
+	assert.Superset(t, []int{3, 1}, []int{1, 2, 3})
+
+	mt := &common.MockT{}
+	assert.Superset(mt, []int{1, 4}, []int{1, 2, 3})
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestMapContains(t *testing.T) {
+	t.Parallel()
+	assert.MapContains(t, map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2})
+
+	mt := &common.MockT{}
+	assert.MapContains(mt, map[string]int{"a": 2}, map[string]int{"a": 1, "b": 2})
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
 func TestNil(t *testing.T) {
 	t.Parallel()
 
@@ -815,3 +897,47 @@ func TestNotNil(t *testing.T) {
 		assert.True(t, mt.FailedNow())
 	})
 }
+
+func TestZero(t *testing.T) {
+	t.Parallel()
+	assert.Zero(t, 0)
+	assert.Zero(t, person{})
+
+	mt := &common.MockT{}
+	assert.Zero(mt, person{Name: "peter"})
+	assert.True(t, mt.Failed())
+	assert.True(t, mt.FailedNow())
+}
+
+func TestNotZero(t *testing.T) {
+	t.Parallel()
+	assert.NotZero(t, 1)
+	assert.NotZero(t, person{Name: "peter"})
+
+	mt := &common.MockT{}
+	assert.NotZero(mt, person{})
+	assert.True(t, mt.Failed())
+	assert.True(t, mt.FailedNow())
+}
+
+func TestEmpty(t *testing.T) {
+	t.Parallel()
+	assert.Empty(t, []int{})
+	assert.Empty(t, "")
+
+	mt := &common.MockT{}
+	assert.Empty(mt, []int{1, 2, 3})
+	assert.True(t, mt.Failed())
+	assert.True(t, mt.FailedNow())
+}
+
+func TestNotEmpty(t *testing.T) {
+	t.Parallel()
+	assert.NotEmpty(t, []int{1, 2, 3})
+	assert.NotEmpty(t, "hello")
+
+	mt := &common.MockT{}
+	assert.NotEmpty(mt, map[string]int{})
+	assert.True(t, mt.Failed())
+	assert.True(t, mt.FailedNow())
+}
@@ -0,0 +1,70 @@
+package assert
+
+import (
+	"time"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+// Eventually passes if condition returns true within timeout. The condition is
+// called immediately and then once every interval until it returns true or
+// timeout elapses.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// If t has a Deadline() method, like *testing.T, the timeout is capped at the
+// test's deadline.
+func Eventually(t common.T, condition func() bool, timeout time.Duration, interval time.Duration) {
+	t.Helper()
+	if !check.Eventually(t, condition, timeout, interval) {
+		t.FailNow()
+	}
+}
+
+// EventuallyWith passes if an attempt of fn passes within timeout. Each attempt
+// receives its own common.T, so normal checks and asserts can be used inside
+// of fn, and an attempt passes if none of them fail.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes the failure messages from the last attempt.
+//
+// If t has a Deadline() method, like *testing.T, the timeout is capped at the
+// test's deadline.
+func EventuallyWith(t common.T, fn func(t common.T), timeout time.Duration, interval time.Duration) {
+	t.Helper()
+	if !check.EventuallyWith(t, fn, timeout, interval) {
+		t.FailNow()
+	}
+}
+
+// Consistently passes if condition returns true every time it is called during
+// duration. The condition is called immediately and then once every interval
+// until it returns false or duration elapses.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// If t has a Deadline() method, like *testing.T, the duration is capped at the
+// test's deadline.
+func Consistently(t common.T, condition func() bool, duration time.Duration, interval time.Duration) {
+	t.Helper()
+	if !check.Consistently(t, condition, duration, interval) {
+		t.FailNow()
+	}
+}
+
+// ConsistentlyWith passes if every attempt of fn during duration passes. Each
+// attempt receives its own common.T, so normal checks and asserts can be used
+// inside of fn, and an attempt passes if none of them fail.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes the failure messages from the failing attempt.
+//
+// If t has a Deadline() method, like *testing.T, the duration is capped at the
+// test's deadline.
+func ConsistentlyWith(t common.T, fn func(t common.T), duration time.Duration, interval time.Duration) {
+	t.Helper()
+	if !check.ConsistentlyWith(t, fn, duration, interval) {
+		t.FailNow()
+	}
+}
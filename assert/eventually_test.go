@@ -0,0 +1,69 @@
+package assert_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+func TestEventually(t *testing.T) {
+	t.Parallel()
+	var calls atomic.Int32
+	assert.Eventually(t, func() bool {
+		return calls.Add(1) == 3
+	}, time.Second, time.Millisecond)
+
+	mt := &common.MockT{}
+	assert.Eventually(mt, func() bool {
+		return false
+	}, 10*time.Millisecond, time.Millisecond)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestEventuallyWith(t *testing.T) {
+	t.Parallel()
+	var calls atomic.Int32
+	assert.EventuallyWith(t, func(t common.T) {
+		assert.Equal(t, int32(3), calls.Add(1))
+	}, time.Second, time.Millisecond)
+
+	mt := &common.MockT{}
+	assert.EventuallyWith(mt, func(t common.T) {
+		check.True(t, false)
+	}, 10*time.Millisecond, time.Millisecond)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestConsistently(t *testing.T) {
+	t.Parallel()
+	assert.Consistently(t, func() bool {
+		return true
+	}, 10*time.Millisecond, time.Millisecond)
+
+	mt := &common.MockT{}
+	assert.Consistently(mt, func() bool {
+		return false
+	}, time.Second, time.Millisecond)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestConsistentlyWith(t *testing.T) {
+	t.Parallel()
+	assert.ConsistentlyWith(t, func(t common.T) {
+		check.True(t, true)
+	}, 10*time.Millisecond, time.Millisecond)
+
+	mt := &common.MockT{}
+	assert.ConsistentlyWith(mt, func(t common.T) {
+		assert.True(t, false)
+	}, time.Second, time.Millisecond)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
@@ -0,0 +1,121 @@
+package assert
+
+import (
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+// InDelta passes if the absolute difference between want and got is at most
+// delta.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// NaN and infinities are handled explicitly: two NaNs are considered equal,
+// and an infinity is only equal to an infinity with the same sign.
+func InDelta[F check.Float](t common.T, want F, got F, delta F) {
+	t.Helper()
+	if !check.InDelta(t, want, got, delta) {
+		t.FailNow()
+	}
+}
+
+// InDeltaSlice passes if want and got have the same length, and InDelta would
+// pass for every pair of elements at the same index.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes every index that is out of tolerance.
+func InDeltaSlice[F check.Float](t common.T, want []F, got []F, delta F) {
+	t.Helper()
+	if !check.InDeltaSlice(t, want, got, delta) {
+		t.FailNow()
+	}
+}
+
+// InDeltaMap passes if want and got have the same keys, and InDelta would pass
+// for every pair of values with the same key.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes every key that is missing or out of tolerance.
+func InDeltaMap[Key comparable, F check.Float](t common.T, want map[Key]F, got map[Key]F, delta F) {
+	t.Helper()
+	if !check.InDeltaMap(t, want, got, delta) {
+		t.FailNow()
+	}
+}
+
+// InEpsilon passes if the relative error between want and got,
+// |want - got| / |want|, is at most epsilon. If want is 0, got must also be 0.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// NaN and infinities are handled explicitly: two NaNs are considered equal,
+// and an infinity is only equal to an infinity with the same sign.
+func InEpsilon[F check.Float](t common.T, want F, got F, epsilon F) {
+	t.Helper()
+	if !check.InEpsilon(t, want, got, epsilon) {
+		t.FailNow()
+	}
+}
+
+// InEpsilonSlice passes if want and got have the same length, and InEpsilon
+// would pass for every pair of elements at the same index.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes every index that is out of tolerance.
+func InEpsilonSlice[F check.Float](t common.T, want []F, got []F, epsilon F) {
+	t.Helper()
+	if !check.InEpsilonSlice(t, want, got, epsilon) {
+		t.FailNow()
+	}
+}
+
+// InEpsilonMap passes if want and got have the same keys, and InEpsilon would
+// pass for every pair of values with the same key.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes every key that is missing or out of tolerance.
+func InEpsilonMap[Key comparable, F check.Float](t common.T, want map[Key]F, got map[Key]F, epsilon F) {
+	t.Helper()
+	if !check.InEpsilonMap(t, want, got, epsilon) {
+		t.FailNow()
+	}
+}
+
+// WithinULP passes if want and got are at most ulps units in the last place
+// apart; that is, if there are fewer than ulps representable floating-point
+// numbers between them. Positive and negative zero are equal.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// NaN and infinities are handled explicitly: two NaNs are considered equal,
+// and an infinity is only equal to an infinity with the same sign.
+func WithinULP[F check.Float](t common.T, want F, got F, ulps uint64) {
+	t.Helper()
+	if !check.WithinULP(t, want, got, ulps) {
+		t.FailNow()
+	}
+}
+
+// WithinULPSlice passes if want and got have the same length, and WithinULP
+// would pass for every pair of elements at the same index.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes every index that is out of tolerance.
+func WithinULPSlice[F check.Float](t common.T, want []F, got []F, ulps uint64) {
+	t.Helper()
+	if !check.WithinULPSlice(t, want, got, ulps) {
+		t.FailNow()
+	}
+}
+
+// WithinULPMap passes if want and got have the same keys, and WithinULP would
+// pass for every pair of values with the same key.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes every key that is missing or out of tolerance.
+func WithinULPMap[Key comparable, F check.Float](t common.T, want map[Key]F, got map[Key]F, ulps uint64) {
+	t.Helper()
+	if !check.WithinULPMap(t, want, got, ulps) {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,77 @@
+package assert_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+func TestInDelta(t *testing.T) {
+	t.Parallel()
+	assert.InDelta(t, 0.3, 0.1+0.2, 1e-9)
+	assert.InDeltaSlice(t, []float64{1, 2}, []float64{1.05, 1.95}, 0.1)
+	assert.InDeltaMap(t, map[string]float64{"a": 1}, map[string]float64{"a": 1.05}, 0.1)
+
+	mt := &common.MockT{}
+	assert.InDelta(mt, 1.0, 2.0, 0.5)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+
+	mt = &common.MockT{}
+	assert.InDeltaSlice(mt, []float64{1, 2}, []float64{1, 3}, 0.5)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+
+	mt = &common.MockT{}
+	assert.InDeltaMap(mt, map[string]float64{"a": 1}, map[string]float64{"b": 1}, 0.5)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestInEpsilon(t *testing.T) {
+	t.Parallel()
+	assert.InEpsilon(t, 100.0, 101.0, 0.01)
+	assert.InEpsilonSlice(t, []float64{100}, []float64{101}, 0.01)
+	assert.InEpsilonMap(t, map[string]float64{"a": 100}, map[string]float64{"a": 101}, 0.01)
+
+	mt := &common.MockT{}
+	assert.InEpsilon(mt, 100.0, 110.0, 0.01)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+
+	mt = &common.MockT{}
+	assert.InEpsilonSlice(mt, []float64{100}, []float64{110}, 0.01)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+
+	mt = &common.MockT{}
+	assert.InEpsilonMap(mt, map[string]float64{"a": 100}, map[string]float64{"a": 110}, 0.01)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestWithinULP(t *testing.T) {
+	t.Parallel()
+	next := math.Nextafter(1, 2)
+	assert.WithinULP(t, 1.0, next, 1)
+	assert.WithinULPSlice(t, []float64{1}, []float64{next}, 1)
+	assert.WithinULPMap(t, map[string]float64{"a": 1}, map[string]float64{"a": next}, 1)
+
+	mt := &common.MockT{}
+	assert.WithinULP(mt, 1.0, next, 0)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+
+	mt = &common.MockT{}
+	assert.WithinULPSlice(mt, []float64{1}, []float64{next}, 0)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+
+	mt = &common.MockT{}
+	assert.WithinULPMap(mt, map[string]float64{"a": 1}, map[string]float64{"a": next}, 0)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
@@ -0,0 +1,38 @@
+package assert
+
+import (
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+// Go calls fn on a new goroutine and returns a Group that waits for it, like
+// check.Go, except that Group.Wait stops the test if any goroutine in the
+// group failed.
+func Go(t common.T, fn func(t common.T)) *Group {
+	return &Group{t: t, group: check.Go(t, fn)}
+}
+
+// Group is a group of goroutines started by Go, whose failures are reported
+// to the test by Wait.
+type Group struct {
+	t     common.T
+	group *check.Group
+}
+
+// Go calls fn on a new goroutine in the group.
+func (g *Group) Go(fn func(t common.T)) {
+	g.group.Go(fn)
+}
+
+// Wait waits for every goroutine in the group to finish, and passes if none
+// of them failed.
+//
+// Otherwise, each goroutine that failed is reported separately, with the
+// failure messages it collected, and the test is immediately failed and
+// stopped with t.FailNow().
+func (g *Group) Wait() {
+	g.t.Helper()
+	if !g.group.Wait() {
+		g.t.FailNow()
+	}
+}
@@ -0,0 +1,33 @@
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/testytest"
+)
+
+func TestGo(t *testing.T) {
+	t.Parallel()
+	g := assert.Go(t, func(t common.T) {
+		assert.True(t, true)
+	})
+	g.Go(func(t common.T) {
+		check.Equal(t, 1, 1)
+	})
+	g.Wait()
+
+	testytest.ExpectFailNow(t, func(t common.T) {
+		g := assert.Go(t, func(t common.T) {
+			check.True(t, false)
+		})
+		g.Wait()
+	})
+	testytest.ExpectMessage(t, "goroutine 1 of 1 failed:\n    group_test.go:", func(t common.T) {
+		assert.Go(t, func(t common.T) {
+			assert.True(t, false)
+		}).Wait()
+	})
+}
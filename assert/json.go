@@ -0,0 +1,23 @@
+package assert
+
+import (
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+// JSONEqual passes if want and got are equivalent JSON documents.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// Both documents are parsed and compared structurally using go-cmp, so the
+// order of object keys and whitespace do not matter. On failure, every
+// difference is reported with the path to the differing value, like
+// "$.items[1].name". You can change the behavior of the comparison using
+// check.JSONExactNumbers, check.JSONIgnorePaths, and
+// check.JSONAllowExtraFields.
+func JSONEqual(t common.T, want []byte, got []byte, opts ...check.JSONOption) {
+	t.Helper()
+	if !check.JSONEqual(t, want, got, opts...) {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,25 @@
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+func TestJSONEqual(t *testing.T) {
+	t.Parallel()
+	assert.JSONEqual(t, []byte(`{"a": 1, "b": 2}`), []byte(`{"b": 2, "a": 1}`))
+	assert.JSONEqual(t,
+		[]byte(`{"meta": {"requestId": "abc"}}`),
+		[]byte(`{"meta": {"requestId": "xyz"}, "extra": true}`),
+		check.JSONIgnorePaths("$.meta.requestId"),
+		check.JSONAllowExtraFields(),
+	)
+
+	mt := &common.MockT{}
+	assert.JSONEqual(mt, []byte(`{"a": 1}`), []byte(`{"a": 2}`))
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
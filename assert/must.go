@@ -0,0 +1,25 @@
+package assert
+
+import (
+	"github.com/peterldowns/testy/common"
+)
+
+// Must turns a check into an assert: the returned function calls check and, if
+// it fails, immediately stops the test with t.FailNow(). check can come from
+// check.Define or be any helper of your own that reports failures through t:
+//
+//	var IsAdult = check.Define(...)
+//	var MustBeAdult = assert.Must(IsAdult)
+//
+//	MustBeAdult(t, user)
+//
+// If you write check by hand, call t.Helper() at its start so that failures
+// are reported at the line that called the assert.
+func Must[T any](check func(t common.T, got T) bool) func(t common.T, got T) {
+	return func(t common.T, got T) {
+		t.Helper()
+		if !check(t, got) {
+			t.FailNow()
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package assert_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+func TestMust(t *testing.T) {
+	t.Parallel()
+	isEven := check.Define(func(n int) (bool, string) {
+		return n%2 == 0, "expected an even number"
+	})
+	mustBeEven := assert.Must(isEven)
+	mustBeEven(t, 4)
+
+	mt := &common.MockT{}
+	_, _, line, _ := runtime.Caller(0)
+	mustBeEven(mt, 3)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+	if messages := mt.Messages(); check.Equal(t, 1, len(messages)) {
+		check.Equal(t, "must_test.go", filepath.Base(messages[0].File))
+		check.Equal(t, line+1, messages[0].Line)
+	}
+
+	// Must also accepts helpers written by hand.
+	mustBePositive := assert.Must(func(t common.T, n int) bool {
+		t.Helper()
+		return check.GreaterThan(t, n, 0)
+	})
+	mustBePositive(t, 1)
+
+	mt = &common.MockT{}
+	_, _, line, _ = runtime.Caller(0)
+	mustBePositive(mt, -1)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+	if messages := mt.Messages(); check.Equal(t, 1, len(messages)) {
+		check.Equal(t, "must_test.go", filepath.Base(messages[0].File))
+		check.Equal(t, line+1, messages[0].Line)
+	}
+}
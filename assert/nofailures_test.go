@@ -7,6 +7,7 @@ import (
 	"github.com/peterldowns/testy/assert"
 	"github.com/peterldowns/testy/check"
 	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/testytest"
 )
 
 func dummyAdd(a, b int) (int, error) {
@@ -42,58 +43,48 @@ func TestNoFailuresDetectsNonCheckFailures(t *testing.T) {
 	t.Parallel()
 	// NoFailures() should call FailNow() even if the failure
 	// on the test was reported by a different framework.
-	mt := &common.MockT{}
-	mt.Error("something went wrong")
-	assert.NoFailures(mt)
-	check.True(t, mt.FailedNow())
+	testytest.ExpectFailNow(t, func(t common.T) {
+		t.Error("something went wrong")
+		assert.NoFailures(t)
+	})
 }
 
 func TestNoErrorsDetectsNonCheckFailures(t *testing.T) {
 	t.Parallel()
 	// NoErrors() should call FailNow() even if the failure
 	// on the test was reported by a different framework.
-	mt := &common.MockT{}
-	mt.Error("something went wrong")
-	assert.NoErrors(mt)
-	check.True(t, mt.FailedNow())
+	testytest.ExpectFailNow(t, func(t common.T) {
+		t.Error("something went wrong")
+		assert.NoErrors(t)
+	})
 }
 
 func TestNoFailuresCallsFailedNow(t *testing.T) {
 	t.Parallel()
 	// Initially, the test hasn't failed, so NoFailures() doesn't call FailNow()
-	mt := &common.MockT{}
-	check.False(t, mt.Failed())
-	assert.NoFailures(mt)
-	check.False(t, mt.FailedNow())
-
-	// Now cause the test to fail.
-	check.True(mt, false)
-	check.True(t, mt.Failed())
-	check.False(t, mt.FailedNow())
-
-	// NoFailures() should have called FailNow()
-	assert.NoFailures(mt)
-	check.True(t, mt.Failed())
-	check.True(t, mt.FailedNow())
+	testytest.ExpectPass(t, func(t common.T) {
+		assert.NoFailures(t)
+	})
+
+	// Once the test has failed, NoFailures() should call FailNow()
+	testytest.ExpectFailNow(t, func(t common.T) {
+		check.True(t, false)
+		assert.NoFailures(t)
+	})
 }
 
 func TestNoErrorsCallsFailedNow(t *testing.T) {
 	t.Parallel()
 	// Initially, the test hasn't failed, so NoErrors() doesn't call FailNow()
-	mt := &common.MockT{}
-	check.False(t, mt.Failed())
-	assert.NoErrors(mt)
-	check.False(t, mt.FailedNow())
-
-	// Now cause the test to fail.
-	check.True(mt, false)
-	check.True(t, mt.Failed())
-	check.False(t, mt.FailedNow())
-
-	// NoErrors() should have called FailNow()
-	assert.NoErrors(mt)
-	check.True(t, mt.Failed())
-	check.True(t, mt.FailedNow())
+	testytest.ExpectPass(t, func(t common.T) {
+		assert.NoErrors(t)
+	})
+
+	// Once the test has failed, NoErrors() should call FailNow()
+	testytest.ExpectFailNow(t, func(t common.T) {
+		check.True(t, false)
+		assert.NoErrors(t)
+	})
 }
 
 func TestNoFailuresCallsThunks(t *testing.T) {
@@ -120,13 +111,14 @@ func TestNoFailuresCallsThunks(t *testing.T) {
 
 		calledFirst := false
 		calledSecond := false
-		mt := &common.MockT{}
-		assert.NoFailures(mt, func() {
-			check.True(mt, false) // intentional failure
-			calledFirst = true
-		}, func() {
-			// Never reached because of the check failure in the first thunk
-			calledSecond = true
+		testytest.ExpectFailNow(t, func(t common.T) {
+			assert.NoFailures(t, func() {
+				check.True(t, false) // intentional failure
+				calledFirst = true
+			}, func() {
+				// Never reached because of the check failure in the first thunk
+				calledSecond = true
+			})
 		})
 		check.True(t, calledFirst)
 		check.False(t, calledSecond)
@@ -136,27 +128,25 @@ func TestNoFailuresCallsThunks(t *testing.T) {
 func TestNoFailuresSkipsThunksIfAlreadyErrored(t *testing.T) {
 	t.Parallel()
 	called := false
-	mt := &common.MockT{}
-	mt.Error()
-	assert.NoFailures(mt, func() {
-		called = true
+	testytest.ExpectFailNow(t, func(t common.T) {
+		t.Error()
+		assert.NoFailures(t, func() {
+			called = true
+		})
 	})
-	check.True(t, mt.Failed())
-	check.True(t, mt.FailedNow())
 	check.False(t, called)
 }
 
 func TestNoErrorsSkipsThunksIfAlreadyErrored(t *testing.T) {
 	t.Parallel()
 	called := false
-	mt := &common.MockT{}
-	mt.Error()
-	assert.NoErrors(mt, func() error {
-		called = true
-		return nil
+	testytest.ExpectFailNow(t, func(t common.T) {
+		t.Error()
+		assert.NoErrors(t, func() error {
+			called = true
+			return nil
+		})
 	})
-	check.True(t, mt.Failed())
-	check.True(t, mt.FailedNow())
 	check.False(t, called)
 }
 
@@ -190,14 +180,15 @@ func TestNoErrorsCallsThunks(t *testing.T) {
 
 		calledFirst := false
 		calledSecond := false
-		mt := &common.MockT{}
-		assert.NoErrors(mt, func() error {
-			calledFirst = true
-			return fmt.Errorf("intentional failure")
-		}, func() error {
-			// Never reached because of the check failure in the first thunk
-			calledSecond = true
-			return nil
+		testytest.ExpectFailNow(t, func(t common.T) {
+			assert.NoErrors(t, func() error {
+				calledFirst = true
+				return fmt.Errorf("intentional failure")
+			}, func() error {
+				// Never reached because of the check failure in the first thunk
+				calledSecond = true
+				return nil
+			})
 		})
 		check.True(t, calledFirst)
 		check.False(t, calledSecond)
@@ -0,0 +1,113 @@
+package assert
+
+import (
+	"cmp"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+// Before passes if small.Compare(big) < 0.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+func Before[Type check.Comparer[Type]](t common.T, small Type, big Type) {
+	t.Helper()
+	if !check.Before(t, small, big) {
+		t.FailNow()
+	}
+}
+
+// After passes if big.Compare(small) > 0.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+func After[Type check.Comparer[Type]](t common.T, big Type, small Type) {
+	t.Helper()
+	if !check.After(t, big, small) {
+		t.FailNow()
+	}
+}
+
+// InRangeCompare passes if lo.Compare(got) <= 0 and got.Compare(hi) <= 0.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+func InRangeCompare[Type check.Comparer[Type]](t common.T, lo Type, hi Type, got Type) {
+	t.Helper()
+	if !check.InRangeCompare(t, lo, hi, got) {
+		t.FailNow()
+	}
+}
+
+// InRange passes if lo <= got <= hi.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+func InRange[Type cmp.Ordered](t common.T, lo Type, hi Type, got Type) {
+	t.Helper()
+	if !check.InRange(t, lo, hi, got) {
+		t.FailNow()
+	}
+}
+
+// InRangeFunc passes if compare(lo, got) <= 0 and compare(got, hi) <= 0.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// compare must return a negative number if a < b, zero if a == b, and a
+// positive number if a > b. Method expressions like (*big.Int).Cmp work well.
+func InRangeFunc[Type any](t common.T, lo Type, hi Type, got Type, compare func(a, b Type) int) {
+	t.Helper()
+	if !check.InRangeFunc(t, lo, hi, got, compare) {
+		t.FailNow()
+	}
+}
+
+// LessThanFunc passes if compare(small, big) < 0.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// compare must return a negative number if a < b, zero if a == b, and a
+// positive number if a > b. Method expressions like (*big.Int).Cmp work well.
+func LessThanFunc[Type any](t common.T, small Type, big Type, compare func(a, b Type) int) {
+	t.Helper()
+	if !check.LessThanFunc(t, small, big, compare) {
+		t.FailNow()
+	}
+}
+
+// LessThanOrEqualFunc passes if compare(small, big) <= 0.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// compare must return a negative number if a < b, zero if a == b, and a
+// positive number if a > b. Method expressions like (*big.Int).Cmp work well.
+func LessThanOrEqualFunc[Type any](t common.T, small Type, big Type, compare func(a, b Type) int) {
+	t.Helper()
+	if !check.LessThanOrEqualFunc(t, small, big, compare) {
+		t.FailNow()
+	}
+}
+
+// GreaterThanFunc passes if compare(big, small) > 0.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// compare must return a negative number if a < b, zero if a == b, and a
+// positive number if a > b. Method expressions like (*big.Int).Cmp work well.
+func GreaterThanFunc[Type any](t common.T, big Type, small Type, compare func(a, b Type) int) {
+	t.Helper()
+	if !check.GreaterThanFunc(t, big, small, compare) {
+		t.FailNow()
+	}
+}
+
+// GreaterThanOrEqualFunc passes if compare(big, small) >= 0.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// compare must return a negative number if a < b, zero if a == b, and a
+// positive number if a > b. Method expressions like (*big.Int).Cmp work well.
+func GreaterThanOrEqualFunc[Type any](t common.T, big Type, small Type, compare func(a, b Type) int) {
+	t.Helper()
+	if !check.GreaterThanOrEqualFunc(t, big, small, compare) {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,71 @@
+package assert_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+func TestBefore(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	assert.Before(t, now, now.Add(time.Second))
+	assert.After(t, now.Add(time.Second), now)
+	assert.InRangeCompare(t, now, now.Add(time.Hour), now.Add(time.Minute))
+
+	mt := &common.MockT{}
+	assert.Before(mt, now, now)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+
+	mt = &common.MockT{}
+	assert.After(mt, now, now)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+
+	mt = &common.MockT{}
+	assert.InRangeCompare(mt, now, now.Add(time.Hour), now.Add(-time.Hour))
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestInRange(t *testing.T) {
+	t.Parallel()
+	assert.InRange(t, 1, 10, 5)
+	assert.InRangeFunc(t, big.NewInt(1), big.NewInt(10), big.NewInt(5), (*big.Int).Cmp)
+
+	mt := &common.MockT{}
+	assert.InRange(mt, 1, 10, 11)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+
+	mt = &common.MockT{}
+	assert.InRangeFunc(mt, big.NewInt(1), big.NewInt(10), big.NewInt(11), (*big.Int).Cmp)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestOrderingFuncs(t *testing.T) {
+	t.Parallel()
+	one, two := big.NewInt(1), big.NewInt(2)
+	assert.LessThanFunc(t, one, two, (*big.Int).Cmp)
+	assert.LessThanOrEqualFunc(t, one, one, (*big.Int).Cmp)
+	assert.GreaterThanFunc(t, two, one, (*big.Int).Cmp)
+	assert.GreaterThanOrEqualFunc(t, two, two, (*big.Int).Cmp)
+
+	for _, fn := range []func(common.T){
+		func(mt common.T) { assert.LessThanFunc(mt, two, one, (*big.Int).Cmp) },
+		func(mt common.T) { assert.LessThanOrEqualFunc(mt, two, one, (*big.Int).Cmp) },
+		func(mt common.T) { assert.GreaterThanFunc(mt, one, two, (*big.Int).Cmp) },
+		func(mt common.T) { assert.GreaterThanOrEqualFunc(mt, one, two, (*big.Int).Cmp) },
+	} {
+		mt := &common.MockT{}
+		fn(mt)
+		check.True(t, mt.Failed())
+		check.True(t, mt.FailedNow())
+	}
+}
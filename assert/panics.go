@@ -0,0 +1,46 @@
+package assert
+
+import (
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+// Panics passes if fn panics when called.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+func Panics(t common.T, fn func()) {
+	t.Helper()
+	if !check.Panics(t, fn) {
+		t.FailNow()
+	}
+}
+
+// NotPanics passes if fn does not panic when called.
+//
+// Otherwise, the panic is recovered and the test is immediately failed and
+// stopped with t.FailNow(). The failure message includes the recovered value
+// and the stack of the panicking goroutine.
+func NotPanics(t common.T, fn func()) {
+	t.Helper()
+	if !check.NotPanics(t, fn) {
+		t.FailNow()
+	}
+}
+
+// PanicsWith passes if fn panics when called, and the recovered value is of
+// the same type as want and equal to it.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// This is a typesafe check for equality using go-cmp, just like Equal.
+//
+// You can change the behavior of the equality checking using the go-cmp/cmp
+// Options system. For more information, see [the go-cmp documentation](https://pkg.go.dev/github.com/google/go-cmp/cmp#Equal).
+func PanicsWith[Type any](t common.T, want Type, fn func(), opts ...gocmp.Option) {
+	t.Helper()
+	if !check.PanicsWith(t, want, fn, opts...) {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,39 @@
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+func TestPanics(t *testing.T) {
+	t.Parallel()
+	assert.Panics(t, func() { panic("oh no") })
+
+	mt := &common.MockT{}
+	assert.Panics(mt, func() {})
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestNotPanics(t *testing.T) {
+	t.Parallel()
+	assert.NotPanics(t, func() {})
+
+	mt := &common.MockT{}
+	assert.NotPanics(mt, func() { panic("oh no") })
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestPanicsWith(t *testing.T) {
+	t.Parallel()
+	assert.PanicsWith(t, person{Name: "peter"}, func() { panic(person{Name: "peter"}) })
+
+	mt := &common.MockT{}
+	assert.PanicsWith(mt, person{Name: "peter"}, func() { panic(person{Name: "bob"}) })
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
@@ -0,0 +1,64 @@
+package assert
+
+import (
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+// Contains passes if s contains substring.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message shows s with the closest match to substring marked.
+func Contains[Sub check.Text, S check.Text](t common.T, substring Sub, s S) {
+	t.Helper()
+	if !check.Contains(t, substring, s) {
+		t.FailNow()
+	}
+}
+
+// NotContains passes if s does not contain substring.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message shows s with the first occurrence of substring marked.
+func NotContains[Sub check.Text, S check.Text](t common.T, substring Sub, s S) {
+	t.Helper()
+	if !check.NotContains(t, substring, s) {
+		t.FailNow()
+	}
+}
+
+// HasPrefix passes if s begins with prefix.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message shows the beginning of s with the part that matches prefix
+// marked.
+func HasPrefix[Prefix check.Text, S check.Text](t common.T, prefix Prefix, s S) {
+	t.Helper()
+	if !check.HasPrefix(t, prefix, s) {
+		t.FailNow()
+	}
+}
+
+// HasSuffix passes if s ends with suffix.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message shows the end of s with the part that matches suffix
+// marked.
+func HasSuffix[Suffix check.Text, S check.Text](t common.T, suffix Suffix, s S) {
+	t.Helper()
+	if !check.HasSuffix(t, suffix, s) {
+		t.FailNow()
+	}
+}
+
+// Matches passes if s contains a match of the regular expression pattern. Use
+// ^ and $ in the pattern to match all of s.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// test also fails if pattern is not a valid regular expression.
+func Matches[S check.Text](t common.T, pattern string, s S) {
+	t.Helper()
+	if !check.Matches(t, pattern, s) {
+		t.FailNow()
+	}
+}
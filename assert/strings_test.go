@@ -0,0 +1,51 @@
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+func TestContains(t *testing.T) {
+	t.Parallel()
+	assert.Contains(t, "ok", "status: ok")
+	assert.NotContains(t, "error", "status: ok")
+
+	mt := &common.MockT{}
+	assert.Contains(mt, "error", "status: ok")
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+
+	mt = &common.MockT{}
+	assert.NotContains(mt, "ok", "status: ok")
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestHasPrefix(t *testing.T) {
+	t.Parallel()
+	assert.HasPrefix(t, "/usr", "/usr/bin")
+	assert.HasSuffix(t, "bin", "/usr/bin")
+
+	mt := &common.MockT{}
+	assert.HasPrefix(mt, "/var", "/usr/bin")
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+
+	mt = &common.MockT{}
+	assert.HasSuffix(mt, "lib", "/usr/bin")
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestMatches(t *testing.T) {
+	t.Parallel()
+	assert.Matches(t, `^\d+$`, "12345")
+
+	mt := &common.MockT{}
+	assert.Matches(mt, `^\d+$`, "123a45")
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
@@ -0,0 +1,18 @@
+package assert
+
+import (
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/match"
+)
+
+// That passes if m matches got.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes the matcher's explanation of why got did not match.
+func That[T any](t common.T, got T, m match.Matcher[T]) {
+	t.Helper()
+	if !check.That(t, got, m) {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,20 @@
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/match"
+)
+
+func TestThat(t *testing.T) {
+	t.Parallel()
+	assert.That(t, "hello", match.Not(match.Eq("")))
+
+	mt := &common.MockT{}
+	assert.That(mt, "", match.Not(match.Eq("")))
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
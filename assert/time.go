@@ -0,0 +1,37 @@
+package assert
+
+import (
+	"time"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+// WithinDuration passes if want and got are at most d apart.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes both times and the signed difference between them.
+//
+// Monotonic clock readings are ignored, so that the comparison matches the
+// wall-clock times printed in the failure message.
+func WithinDuration(t common.T, want time.Time, got time.Time, d time.Duration) {
+	t.Helper()
+	if !check.WithinDuration(t, want, got, d) {
+		t.FailNow()
+	}
+}
+
+// TimeEqual passes if want and got represent the same instant, regardless of
+// their locations or monotonic clock readings.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow(). The
+// failure message includes both times and the signed difference between them.
+//
+// Unlike time.Time.Equal, this ignores monotonic clock readings even if both
+// times have them.
+func TimeEqual(t common.T, want time.Time, got time.Time) {
+	t.Helper()
+	if !check.TimeEqual(t, want, got) {
+		t.FailNow()
+	}
+}
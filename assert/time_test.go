@@ -0,0 +1,32 @@
+package assert_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+func TestWithinDuration(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	assert.WithinDuration(t, now, now.Add(time.Second), time.Second)
+
+	mt := &common.MockT{}
+	assert.WithinDuration(mt, now, now.Add(time.Hour), time.Second)
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestTimeEqual(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	assert.TimeEqual(t, now, now.UTC())
+
+	mt := &common.MockT{}
+	assert.TimeEqual(mt, now, now.Add(time.Second))
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
@@ -0,0 +1,143 @@
+package testy
+
+import (
+	"time"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+// True passes if x == true, and returns true if it passed. See [check.True].
+func (c Check) True(x bool) bool {
+	c.t.Helper()
+	return check.True(c.t, x)
+}
+
+// False passes if x == false, and returns true if it passed. See [check.False].
+func (c Check) False(x bool) bool {
+	c.t.Helper()
+	return check.False(c.t, x)
+}
+
+// Error passes if err != nil, and returns true if it passed. See [check.Error].
+func (c Check) Error(err error) bool {
+	c.t.Helper()
+	return check.Error(c.t, err)
+}
+
+// NoError passes if err == nil, and returns true if it passed. See
+// [check.NoError].
+func (c Check) NoError(err error) bool {
+	c.t.Helper()
+	return check.NoError(c.t, err)
+}
+
+// ErrorIs passes if errors.Is(err, target), and returns true if it passed. See
+// [check.ErrorIs].
+func (c Check) ErrorIs(err error, target error) bool {
+	c.t.Helper()
+	return check.ErrorIs(c.t, err, target)
+}
+
+// ErrorContains passes if err != nil and err.Error() contains substring, and
+// returns true if it passed. See [check.ErrorContains].
+func (c Check) ErrorContains(err error, substring string) bool {
+	c.t.Helper()
+	return check.ErrorContains(c.t, err, substring)
+}
+
+// Nil passes if val == nil, and returns true if it passed. See [check.Nil].
+func (c Check) Nil(val any) bool {
+	c.t.Helper()
+	return check.Nil(c.t, val)
+}
+
+// NotNil passes if val != nil, and returns true if it passed. See
+// [check.NotNil].
+func (c Check) NotNil(val any) bool {
+	c.t.Helper()
+	return check.NotNil(c.t, val)
+}
+
+// Empty passes if val has a length of zero, and returns true if it passed. See
+// [check.Empty].
+func (c Check) Empty(val any) bool {
+	c.t.Helper()
+	return check.Empty(c.t, val)
+}
+
+// NotEmpty passes if val has a length greater than zero, and returns true if it
+// passed. See [check.NotEmpty].
+func (c Check) NotEmpty(val any) bool {
+	c.t.Helper()
+	return check.NotEmpty(c.t, val)
+}
+
+// Eventually passes if condition returns true within timeout, and returns true
+// if it passed. See [check.Eventually].
+func (c Check) Eventually(condition func() bool, timeout time.Duration, interval time.Duration) bool {
+	c.t.Helper()
+	return check.Eventually(c.t, condition, timeout, interval)
+}
+
+// EventuallyWith passes if an attempt of fn passes within timeout, and returns
+// true if it passed. See [check.EventuallyWith].
+func (c Check) EventuallyWith(fn func(t common.T), timeout time.Duration, interval time.Duration) bool {
+	c.t.Helper()
+	return check.EventuallyWith(c.t, fn, timeout, interval)
+}
+
+// Consistently passes if condition returns true for all of duration, and
+// returns true if it passed. See [check.Consistently].
+func (c Check) Consistently(condition func() bool, duration time.Duration, interval time.Duration) bool {
+	c.t.Helper()
+	return check.Consistently(c.t, condition, duration, interval)
+}
+
+// ConsistentlyWith passes if every attempt of fn passes for all of duration,
+// and returns true if it passed. See [check.ConsistentlyWith].
+func (c Check) ConsistentlyWith(fn func(t common.T), duration time.Duration, interval time.Duration) bool {
+	c.t.Helper()
+	return check.ConsistentlyWith(c.t, fn, duration, interval)
+}
+
+// JSONEqual passes if want and got are equivalent JSON documents, and returns
+// true if it passed. See [check.JSONEqual].
+func (c Check) JSONEqual(want []byte, got []byte, opts ...check.JSONOption) bool {
+	c.t.Helper()
+	return check.JSONEqual(c.t, want, got, opts...)
+}
+
+// Panics passes if fn panics, and returns true if it passed. See
+// [check.Panics].
+func (c Check) Panics(fn func()) bool {
+	c.t.Helper()
+	return check.Panics(c.t, fn)
+}
+
+// NotPanics passes if fn does not panic, and returns true if it passed. See
+// [check.NotPanics].
+func (c Check) NotPanics(fn func()) bool {
+	c.t.Helper()
+	return check.NotPanics(c.t, fn)
+}
+
+// WithinDuration passes if want and got are at most d apart, and returns true
+// if it passed. See [check.WithinDuration].
+func (c Check) WithinDuration(want time.Time, got time.Time, d time.Duration) bool {
+	c.t.Helper()
+	return check.WithinDuration(c.t, want, got, d)
+}
+
+// TimeEqual passes if want and got are the same instant, and returns true if it
+// passed. See [check.TimeEqual].
+func (c Check) TimeEqual(want time.Time, got time.Time) bool {
+	c.t.Helper()
+	return check.TimeEqual(c.t, want, got)
+}
+
+// Go calls fn on a new goroutine and returns a group that reports its failures
+// when Wait is called. See [check.Go].
+func (c Check) Go(fn func(t common.T)) *check.Group {
+	return check.Go(c.t, fn)
+}
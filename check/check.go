@@ -2,12 +2,18 @@ package check
 
 import (
 	"cmp"
+	"errors"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
 	gocmp "github.com/google/go-cmp/cmp"
 
 	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/internal/diff"
+	"github.com/peterldowns/testy/internal/preview"
+	"github.com/peterldowns/testy/report"
 )
 
 // True passes and returns true if x == true.
@@ -19,7 +25,11 @@ func True(t common.T, x bool) bool {
 	if x {
 		return true
 	}
-	t.Error("expected true")
+	report.Report(t, report.Failure{
+		Message: "expected true",
+		Want:    true,
+		Got:     x,
+	})
 	return false
 }
 
@@ -32,7 +42,11 @@ func False(t common.T, x bool) bool {
 	if !x {
 		return true
 	}
-	t.Error("expected false")
+	report.Report(t, report.Failure{
+		Message: "expected false",
+		Want:    false,
+		Got:     x,
+	})
 	return false
 }
 
@@ -46,13 +60,28 @@ func False(t common.T, x bool) bool {
 //
 // You can change the behavior of the equality checking using the go-cmp/cmp
 // Options system. For more information, see [the go-cmp documentation](https://pkg.go.dev/github.com/google/go-cmp/cmp#Equal).
+//
+// If want and got are strings or byte slices, the failure message shows a
+// line-based unified diff of multi-line values, or a caret under the first
+// differing character of single-line values.
 func Equal[Type any](t common.T, want Type, got Type, opts ...gocmp.Option) bool {
 	t.Helper()
-	diff := gocmp.Diff(want, got, opts...)
-	if diff == "" {
+	d := gocmp.Diff(want, got, opts...)
+	if d == "" {
 		return true
 	}
-	t.Error(fmt.Sprintf("expected want == got\n--- want\n+++ got\n%#v", diff))
+	message := fmt.Sprintf("expected want == got\n--- want\n+++ got\n%#v", d)
+	if wantText, gotText, ok := diff.AsText(want, got); ok && wantText != gotText {
+		d = diff.Text(wantText, gotText)
+		message = fmt.Sprintf("expected want == got\n%s", d)
+	}
+	report.Report(t, report.Failure{
+		Message: message,
+		Want:    want,
+		Got:     got,
+		Diff:    d,
+		Options: opts,
+	})
 	return false
 }
 
@@ -71,7 +100,12 @@ func NotEqual[Type any](t common.T, want Type, got Type, opts ...gocmp.Option) b
 	if !gocmp.Equal(want, got, opts...) {
 		return true
 	}
-	t.Error(fmt.Sprintf("expected want != got\nwant: %#v\n got: %#v", want, got))
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected want != got\nwant: %#v\n got: %#v", want, got),
+		Want:    want,
+		Got:     got,
+		Options: opts,
+	})
 	return false
 }
 
@@ -84,7 +118,11 @@ func LessThan[Type cmp.Ordered](t common.T, small Type, big Type) bool {
 	if small < big {
 		return true
 	}
-	t.Error(fmt.Sprintf("expected %#v < %#v", small, big))
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected %#v < %#v", small, big),
+		Want:    big,
+		Got:     small,
+	})
 	return false
 }
 
@@ -97,7 +135,11 @@ func LessThanOrEqual[Type cmp.Ordered](t common.T, small Type, big Type) bool {
 	if small <= big {
 		return true
 	}
-	t.Error(fmt.Sprintf("expected %#v <= %#v", small, big))
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected %#v <= %#v", small, big),
+		Want:    big,
+		Got:     small,
+	})
 	return false
 }
 
@@ -110,7 +152,11 @@ func GreaterThan[Type cmp.Ordered](t common.T, big Type, small Type) bool {
 	if big > small {
 		return true
 	}
-	t.Error(fmt.Sprintf("expected %#v > %#v", big, small))
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected %#v > %#v", big, small),
+		Want:    small,
+		Got:     big,
+	})
 	return false
 }
 
@@ -123,7 +169,11 @@ func GreaterThanOrEqual[Type cmp.Ordered](t common.T, big Type, small Type) bool
 	if big >= small {
 		return true
 	}
-	t.Error(fmt.Sprintf("expected %#v >= %#v", big, small))
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected %#v >= %#v", big, small),
+		Want:    small,
+		Got:     big,
+	})
 	return false
 }
 
@@ -136,7 +186,10 @@ func Error(t common.T, err error) bool {
 	if err != nil {
 		return true
 	}
-	t.Error(fmt.Sprintf("expected non-<nil> error, received %#v", err))
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected non-<nil> error, received %#v", err),
+		Got:     err,
+	})
 	return false
 }
 
@@ -149,7 +202,66 @@ func NoError(t common.T, err error) bool {
 	if err == nil {
 		return true
 	}
-	t.Error(fmt.Sprintf("expected <nil> error, received %#v", err))
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected <nil> error, received %#v", err),
+		Got:     err,
+	})
+	return false
+}
+
+// ErrorIs passes and returns true if errors.Is(err, target).
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes the full
+// tree of errors wrapped by err.
+func ErrorIs(t common.T, err error, target error) bool {
+	t.Helper()
+	if errors.Is(err, target) {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected errors.Is(err, target)\ntarget: %#v\n   err: %s", target, errorTree(err, "        ")),
+		Want:    target,
+		Got:     err,
+	})
+	return false
+}
+
+// ErrorAs passes and returns the first error in err's tree that matches the
+// type E, and true, if errors.As(err, &target).
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// the zero value of E and false, and the test continues running. The failure
+// message includes the full tree of errors wrapped by err.
+func ErrorAs[E error](t common.T, err error) (E, bool) {
+	t.Helper()
+	var target E
+	if errors.As(err, &target) {
+		return target, true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected errors.As(err, *%s)\nerr: %s", typeName[E](), errorTree(err, "     ")),
+		Got:     err,
+	})
+	return target, false
+}
+
+// ErrorContains passes and returns true if err != nil and err.Error() contains
+// substring.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes the full
+// tree of errors wrapped by err.
+func ErrorContains(t common.T, err error, substring string) bool {
+	t.Helper()
+	if err != nil && strings.Contains(err.Error(), substring) {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected error message to contain substring\nsubstring: %q\n      err: %s", substring, errorTree(err, "           ")),
+		Want:    substring,
+		Got:     err,
+	})
 	return false
 }
 
@@ -162,12 +274,15 @@ func NoError(t common.T, err error) bool {
 // Options system. For more information, see [the go-cmp documentation](https://pkg.go.dev/github.com/google/go-cmp/cmp#Equal).
 func In[Type any](t common.T, element Type, slice []Type, opts ...gocmp.Option) bool {
 	t.Helper()
-	for _, value := range slice {
-		if gocmp.Equal(element, value, opts...) {
-			return true
-		}
+	if indexOf(element, slice, opts...) != -1 {
+		return true
 	}
-	t.Error(fmt.Sprintf("expected slice to contain element:\nelement: %#v\n", element))
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected slice to contain element:\nelement: %#v\n", element),
+		Want:    element,
+		Got:     slice,
+		Options: opts,
+	})
 	return false
 }
 
@@ -180,13 +295,125 @@ func In[Type any](t common.T, element Type, slice []Type, opts ...gocmp.Option)
 // Options system. For more information, see [the go-cmp documentation](https://pkg.go.dev/github.com/google/go-cmp/cmp#Equal).
 func NotIn[Type any](t common.T, element Type, slice []Type, opts ...gocmp.Option) bool {
 	t.Helper()
-	for _, value := range slice {
-		if gocmp.Equal(element, value, opts...) {
-			t.Error(fmt.Sprintf("expected slice to not contain element\nelement: %#v\n  found: %#v", element, value))
-			return false
+	i := indexOf(element, slice, opts...)
+	if i == -1 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected slice to not contain element\nelement: %#v\n  found: %#v", element, slice[i]),
+		Want:    element,
+		Got:     slice,
+		Options: opts,
+	})
+	return false
+}
+
+// ElementsMatch passes and returns true if want and got contain the same
+// elements, the same number of times, in any order.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes the
+// elements of want that are missing from got, and the extra elements in got
+// that are not in want.
+//
+// Elements are compared using go-cmp, not ==. You can change the behavior of
+// the equality checking using the go-cmp/cmp Options system. For more
+// information, see [the go-cmp documentation](https://pkg.go.dev/github.com/google/go-cmp/cmp#Equal).
+func ElementsMatch[Type any](t common.T, want []Type, got []Type, opts ...gocmp.Option) bool {
+	t.Helper()
+	missing, extra := unmatchedElements(want, got, opts...)
+	if len(missing) == 0 && len(extra) == 0 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected want and got to contain the same elements\nmissing from got: %s\n   extra in got: %s", preview.Value(missing), preview.Value(extra)),
+		Want:    want,
+		Got:     got,
+		Options: opts,
+	})
+	return false
+}
+
+// Subset passes and returns true if every element of got is also an element
+// of want.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes the
+// elements of got that are not in want.
+//
+// You can change the behavior of the equality checking using the go-cmp/cmp
+// Options system. For more information, see [the go-cmp documentation](https://pkg.go.dev/github.com/google/go-cmp/cmp#Equal).
+func Subset[Type any](t common.T, want []Type, got []Type, opts ...gocmp.Option) bool {
+	t.Helper()
+	extra := missingElements(got, want, opts...)
+	if len(extra) == 0 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected every element of got to be in want\nnot in want: %s", preview.Value(extra)),
+		Want:    want,
+		Got:     got,
+		Options: opts,
+	})
+	return false
+}
+
+// Superset passes and returns true if every element of want is also an
+// element of got.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes the
+// elements of want that are missing from got.
+//
+// You can change the behavior of the equality checking using the go-cmp/cmp
+// Options system. For more information, see [the go-cmp documentation](https://pkg.go.dev/github.com/google/go-cmp/cmp#Equal).
+func Superset[Type any](t common.T, want []Type, got []Type, opts ...gocmp.Option) bool {
+	t.Helper()
+	missing := missingElements(want, got, opts...)
+	if len(missing) == 0 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected got to contain every element of want\nmissing from got: %s", preview.Value(missing)),
+		Want:    want,
+		Got:     got,
+		Options: opts,
+	})
+	return false
+}
+
+// MapContains passes and returns true if every key in want is also a key in
+// got, with an equal value.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message names each key
+// that is missing from got or that has a different value.
+//
+// You can change the behavior of the equality checking using the go-cmp/cmp
+// Options system. For more information, see [the go-cmp documentation](https://pkg.go.dev/github.com/google/go-cmp/cmp#Equal).
+func MapContains[Key comparable, Value any](t common.T, want map[Key]Value, got map[Key]Value, opts ...gocmp.Option) bool {
+	t.Helper()
+	var problems []string
+	for _, key := range sortedKeys(want) {
+		gotValue, ok := got[key]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("missing key: %#v", key))
+			continue
+		}
+		if !gocmp.Equal(want[key], gotValue, opts...) {
+			problems = append(problems, fmt.Sprintf("different value for key %#v\n  want: %s\n   got: %s", key, preview.Value(want[key]), preview.Value(gotValue)))
 		}
 	}
-	return true
+	if len(problems) == 0 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected got to contain every entry of want\n%s", strings.Join(problems, "\n")),
+		Want:    want,
+		Got:     got,
+		Options: opts,
+	})
+	return false
 }
 
 // Nil passes and returns true if val == nil.
@@ -210,7 +437,10 @@ func Nil(t common.T, val any) bool {
 	if isNil(val) {
 		return true
 	}
-	t.Error(fmt.Sprintf("expected <nil>, received %#v", val))
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected <nil>, received %#v", val),
+		Got:     val,
+	})
 	return false
 }
 
@@ -235,10 +465,207 @@ func NotNil(t common.T, v any) bool {
 	if !isNil(v) {
 		return true
 	}
-	t.Error(fmt.Sprintf("expected non-<nil> value, received %#v", v))
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected non-<nil> value, received %#v", v),
+		Got:     v,
+	})
+	return false
+}
+
+// Zero passes and returns true if val is the zero value of its type.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// Uses reflection so that types that are not comparable, like structs with
+// slice fields, can still be checked.
+func Zero[Type any](t common.T, val Type) bool {
+	t.Helper()
+	if isZero(val) {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected zero value, received %s", preview.Value(val)),
+		Got:     val,
+	})
+	return false
+}
+
+// NotZero passes and returns true if val is not the zero value of its type.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// Uses reflection so that types that are not comparable, like structs with
+// slice fields, can still be checked.
+func NotZero[Type any](t common.T, val Type) bool {
+	t.Helper()
+	if !isZero(val) {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected non-zero value, received %s", preview.Value(val)),
+		Got:     val,
+	})
+	return false
+}
+
+// Empty passes and returns true if val has no elements.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// Uses reflection because Go doesn't have a type constraint for "has a
+// length". Supports the following types, and fails for any other type:
+//
+//   - nil
+//   - slice
+//   - map
+//   - string
+//   - channel
+//   - array
+//   - iter.Seq and iter.Seq2
+func Empty(t common.T, val any) bool {
+	t.Helper()
+	c, ok := newCollection(val)
+	if !ok {
+		report.Report(t, report.Failure{
+			Message: fmt.Sprintf("expected empty value, received a value without a length: %s", preview.Value(val)),
+			Got:     val,
+		})
+		return false
+	}
+	if c.length == 0 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected empty value\nlength: %s\n value: %s", c.describeLength(), c.preview()),
+		Got:     val,
+	})
+	return false
+}
+
+// NotEmpty passes and returns true if val has at least one element.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// Uses reflection because Go doesn't have a type constraint for "has a
+// length". Supports the following types, and fails for any other type:
+//
+//   - nil
+//   - slice
+//   - map
+//   - string
+//   - channel
+//   - array
+//   - iter.Seq and iter.Seq2
+func NotEmpty(t common.T, val any) bool {
+	t.Helper()
+	c, ok := newCollection(val)
+	if !ok {
+		report.Report(t, report.Failure{
+			Message: fmt.Sprintf("expected non-empty value, received a value without a length: %s", preview.Value(val)),
+			Got:     val,
+		})
+		return false
+	}
+	if c.length != 0 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected non-empty value\nlength: %s\n value: %s", c.describeLength(), c.preview()),
+		Got:     val,
+	})
 	return false
 }
 
+// indexOf returns the index of the first element of slice that is equal to
+// element, or -1 if there is none.
+func indexOf[Type any](element Type, slice []Type, opts ...gocmp.Option) int {
+	for i, value := range slice {
+		if gocmp.Equal(element, value, opts...) {
+			return i
+		}
+	}
+	return -1
+}
+
+// missingElements returns the elements of want that are not in got.
+func missingElements[Type any](want []Type, got []Type, opts ...gocmp.Option) []Type {
+	var missing []Type
+	for _, element := range want {
+		if indexOf(element, got, opts...) == -1 {
+			missing = append(missing, element)
+		}
+	}
+	return missing
+}
+
+// sortedKeys returns the keys of m, sorted by their formatted values so that
+// failure messages are deterministic.
+func sortedKeys[Key comparable, Value any](m map[Key]Value) []Key {
+	keys := make([]Key, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprintf("%#v", keys[i]) < fmt.Sprintf("%#v", keys[j])
+	})
+	return keys
+}
+
+// unmatchedElements pairs each element of want with an equal element of got,
+// and returns the elements of each slice that could not be paired. Equality
+// with go-cmp options like cmpopts.EquateApprox isn't transitive, so pairing
+// each element with the first equal one can miss a pairing that exists.
+// Instead, this finds a maximum matching between the slices by looking for
+// augmenting paths.
+func unmatchedElements[Type any](want []Type, got []Type, opts ...gocmp.Option) ([]Type, []Type) {
+	equal := make([][]bool, len(want))
+	for i, w := range want {
+		equal[i] = make([]bool, len(got))
+		for j, g := range got {
+			equal[i][j] = gocmp.Equal(w, g, opts...)
+		}
+	}
+	// partner[j] is the index of the element of want paired with got[j], or
+	// -1 if it hasn't been paired.
+	partner := make([]int, len(got))
+	for j := range partner {
+		partner[j] = -1
+	}
+	// augment pairs want[i] with an element of got, re-pairing elements that
+	// were already paired if necessary, and returns false if it can't.
+	var augment func(i int, visited []bool) bool
+	augment = func(i int, visited []bool) bool {
+		for j := range got {
+			if !equal[i][j] || visited[j] {
+				continue
+			}
+			visited[j] = true
+			if partner[j] == -1 || augment(partner[j], visited) {
+				partner[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	var missing []Type
+	for i, w := range want {
+		if !augment(i, make([]bool, len(got))) {
+			missing = append(missing, w)
+		}
+	}
+	var extra []Type
+	for j, g := range got {
+		if partner[j] == -1 {
+			extra = append(extra, g)
+		}
+	}
+	return missing, extra
+}
+
 // reflection-based implementation
 func isNil(object any) bool {
 	if object == nil {
@@ -260,3 +687,9 @@ func isNil(object any) bool {
 		return false
 	}
 }
+
+// reflection-based implementation that also handles values whose type is an
+// interface, such as a nil error.
+func isZero[Type any](val Type) bool {
+	return reflect.ValueOf(&val).Elem().IsZero()
+}
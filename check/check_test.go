@@ -1,35 +1,41 @@
 package check_test
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"net/netip"
+	"strings"
 	"testing"
 	"time"
 	"unsafe"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/peterldowns/testy/check"
 	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/match"
+	"github.com/peterldowns/testy/testytest"
 )
 
 func TestTrue(t *testing.T) {
 	t.Parallel()
 	check.True(t, true)
 
-	mt := &common.MockT{}
-	check.True(mt, false)
-	check.True(t, mt.Failed())
-	check.False(t, mt.FailedNow())
+	testytest.ExpectFail(t, func(t common.T) {
+		check.True(t, false)
+	})
 }
 
 func TestFalse(t *testing.T) {
 	t.Parallel()
 	check.False(t, false)
 
-	mt := &common.MockT{}
-	check.False(mt, true)
-	check.True(t, mt.Failed())
-	check.False(t, mt.FailedNow())
+	testytest.ExpectFail(t, func(t common.T) {
+		check.False(t, true)
+	})
 }
 
 type person struct {
@@ -50,30 +56,27 @@ func TestEqual(t *testing.T) {
 		t.Parallel()
 		check.Equal(t, 1, 1)
 
-		mt := &common.MockT{}
-		check.Equal(mt, 1, 2)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Equal(t, 1, 2)
+		})
 	})
 	t.Run("slices", func(t *testing.T) {
 		t.Parallel()
 		emptySlice := []string{}
 		check.Equal(t, emptySlice, emptySlice)
 
-		mt := &common.MockT{}
-		check.Equal(mt, emptySlice, []string{"hello"})
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Equal(t, emptySlice, []string{"hello"})
+		})
 	})
 	t.Run("custom structs", func(t *testing.T) {
 		t.Parallel()
 		customStruct := person{Name: "peter"}
 		check.Equal(t, customStruct, customStruct)
 
-		mt := &common.MockT{}
-		check.Equal(mt, customStruct, person{Name: "bob"})
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Equal(t, customStruct, person{Name: "bob"})
+		})
 	})
 
 	t.Run("maps", func(t *testing.T) {
@@ -84,10 +87,9 @@ func TestEqual(t *testing.T) {
 		}
 		check.Equal(t, mapdata, mapdata)
 
-		mt := &common.MockT{}
-		check.Equal(mt, mapdata, map[string]int{"hello": 1})
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Equal(t, mapdata, map[string]int{"hello": 1})
+		})
 	})
 
 	t.Run("hidden struct fields with cmp.opts", func(t *testing.T) {
@@ -102,15 +104,14 @@ func TestEqual(t *testing.T) {
 			cmp.AllowUnexported(hiddenPerson{}),
 		)
 
-		mt := &common.MockT{}
-		check.Equal(
-			mt,
-			hiddenPerson{Name: "Peter", hidden: true},
-			hiddenPerson{Name: "Peter", hidden: false},
-			cmp.AllowUnexported(hiddenPerson{}),
-		)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Equal(
+				t,
+				hiddenPerson{Name: "Peter", hidden: true},
+				hiddenPerson{Name: "Peter", hidden: false},
+				cmp.AllowUnexported(hiddenPerson{}),
+			)
+		})
 	})
 
 	t.Run("time.time structs with custom .equals", func(t *testing.T) {
@@ -121,16 +122,44 @@ func TestEqual(t *testing.T) {
 		timeStruct := time.Now()
 		check.Equal(t, timeStruct, timeStruct.UTC())
 
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Equal(
+				t,
+				timeStruct,
+				timeStruct.Add(1*time.Hour),
+				cmp.AllowUnexported(hiddenPerson{}),
+			)
+		})
+	})
+}
+
+func TestEqualText(t *testing.T) {
+	t.Parallel()
+	check.Equal(t, "hello\nworld\n", "hello\nworld\n")
+	check.Equal(t, []byte("hello"), []byte("hello"))
+	check.Equal(t, path("/usr/bin"), path("/usr/bin"))
+
+	for _, tc := range []struct {
+		want, got any
+	}{
+		{"hello world", "hello wurld"},
+		{"hello\nworld\n", "hello\nworld"},
+		{[]byte("hello\nworld\n"), []byte("goodbye\nworld\n")},
+		{path("/usr/bin"), path("/usr/lib")},
+		// With Equal[any], want and got can be different types, which are
+		// never equal and are shown with a go-cmp diff instead of a text diff.
+		{[]byte("a"), "b"},
+		{"a", []byte("a")},
+		{path("/usr/bin"), "/usr/bin"},
+	} {
 		mt := &common.MockT{}
-		check.Equal(
-			mt,
-			timeStruct,
-			timeStruct.Add(1*time.Hour),
-			cmp.AllowUnexported(hiddenPerson{}),
-		)
+		check.False(t, check.Equal(mt, tc.want, tc.got))
 		check.True(t, mt.Failed())
 		check.False(t, mt.FailedNow())
-	})
+		for _, m := range mt.Messages() {
+			check.NotContains(t, "Value>", m.Text)
+		}
+	}
 }
 
 func TestNotEqual(t *testing.T) {
@@ -140,32 +169,29 @@ func TestNotEqual(t *testing.T) {
 		t.Parallel()
 		check.NotEqual(t, 1, 2)
 
-		mt := &common.MockT{}
-		check.NotEqual(mt, 1, 1)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.NotEqual(t, 1, 1)
+		})
 	})
 
 	t.Run("slices", func(t *testing.T) {
 		t.Parallel()
 		check.NotEqual(t, []string{}, []string{"hello"})
 
-		mt := &common.MockT{}
-		emptySlice := []string{}
-		check.NotEqual(mt, emptySlice, emptySlice)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			emptySlice := []string{}
+			check.NotEqual(t, emptySlice, emptySlice)
+		})
 	})
 
 	t.Run("custom structs", func(t *testing.T) {
 		t.Parallel()
 		check.NotEqual(t, person{Name: "peter"}, person{Name: "bob"})
 
-		mt := &common.MockT{}
-		customStruct := person{Name: "peter"}
-		check.NotEqual(mt, customStruct, customStruct)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			customStruct := person{Name: "peter"}
+			check.NotEqual(t, customStruct, customStruct)
+		})
 	})
 
 	t.Run("maps", func(t *testing.T) {
@@ -176,10 +202,9 @@ func TestNotEqual(t *testing.T) {
 		}
 		check.NotEqual(t, mapdata, map[string]int{"hello": 1})
 
-		mt := &common.MockT{}
-		check.NotEqual(mt, mapdata, mapdata)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.NotEqual(t, mapdata, mapdata)
+		})
 	})
 
 	t.Run("hidden struct fields with cmp.opts", func(t *testing.T) {
@@ -194,15 +219,14 @@ func TestNotEqual(t *testing.T) {
 			cmp.AllowUnexported(hiddenPerson{}),
 		)
 
-		mt := &common.MockT{}
-		check.NotEqual(
-			mt,
-			customStructWithHiddenField,
-			customStructWithHiddenField,
-			cmp.AllowUnexported(hiddenPerson{}),
-		)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.NotEqual(
+				t,
+				customStructWithHiddenField,
+				customStructWithHiddenField,
+				cmp.AllowUnexported(hiddenPerson{}),
+			)
+		})
 	})
 
 	t.Run("time.time structs with custom .equals", func(t *testing.T) {
@@ -213,15 +237,14 @@ func TestNotEqual(t *testing.T) {
 		timeStruct := time.Now()
 		check.NotEqual(t, timeStruct, timeStruct.Add(1*time.Hour))
 
-		mt := &common.MockT{}
-		check.NotEqual(
-			mt,
-			timeStruct,
-			timeStruct.UTC(),
-			cmp.AllowUnexported(hiddenPerson{}),
-		)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.NotEqual(
+				t,
+				timeStruct,
+				timeStruct.UTC(),
+				cmp.AllowUnexported(hiddenPerson{}),
+			)
+		})
 	})
 }
 
@@ -233,15 +256,13 @@ func TestLess(t *testing.T) {
 		check.LessThanOrEqual(t, 1.0, 2.0)
 		check.LessThanOrEqual(t, 1.0, 1.0)
 
-		mt := &common.MockT{}
-		check.LessThan(mt, 1.0, 1.0)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.LessThan(t, 1.0, 1.0)
+		})
 
-		mt = &common.MockT{}
-		check.LessThanOrEqual(mt, 2.0, 1.0)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.LessThanOrEqual(t, 2.0, 1.0)
+		})
 	})
 	t.Run("int", func(t *testing.T) {
 		t.Parallel()
@@ -249,15 +270,13 @@ func TestLess(t *testing.T) {
 		check.LessThanOrEqual(t, 1, 2)
 		check.LessThanOrEqual(t, 1, 1)
 
-		mt := &common.MockT{}
-		check.LessThan(mt, 1, 1)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.LessThan(t, 1, 1)
+		})
 
-		mt = &common.MockT{}
-		check.LessThanOrEqual(mt, 2, 1)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.LessThanOrEqual(t, 2, 1)
+		})
 	})
 	t.Run("string", func(t *testing.T) {
 		t.Parallel()
@@ -265,15 +284,13 @@ func TestLess(t *testing.T) {
 		check.LessThanOrEqual(t, "aaa", "bbb")
 		check.LessThanOrEqual(t, "aaa", "aaa")
 
-		mt := &common.MockT{}
-		check.LessThan(mt, "aaa", "aaa")
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.LessThan(t, "aaa", "aaa")
+		})
 
-		mt = &common.MockT{}
-		check.LessThanOrEqual(mt, "bbb", "aaa")
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.LessThanOrEqual(t, "bbb", "aaa")
+		})
 	})
 	t.Run("rune", func(t *testing.T) {
 		t.Parallel()
@@ -281,15 +298,13 @@ func TestLess(t *testing.T) {
 		check.LessThanOrEqual(t, 'a', 'b')
 		check.LessThanOrEqual(t, 'a', 'a')
 
-		mt := &common.MockT{}
-		check.LessThan(mt, 'a', 'a')
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.LessThan(t, 'a', 'a')
+		})
 
-		mt = &common.MockT{}
-		check.LessThanOrEqual(mt, 'b', 'a')
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.LessThanOrEqual(t, 'b', 'a')
+		})
 	})
 }
 
@@ -301,15 +316,13 @@ func TestGreater(t *testing.T) {
 		check.GreaterThanOrEqual(t, 2.0, 1.0)
 		check.GreaterThanOrEqual(t, 2.0, 2.0)
 
-		mt := &common.MockT{}
-		check.GreaterThan(mt, 2.0, 2.0)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.GreaterThan(t, 2.0, 2.0)
+		})
 
-		mt = &common.MockT{}
-		check.GreaterThanOrEqual(mt, 1.0, 2.0)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.GreaterThanOrEqual(t, 1.0, 2.0)
+		})
 	})
 	t.Run("int", func(t *testing.T) {
 		t.Parallel()
@@ -317,15 +330,13 @@ func TestGreater(t *testing.T) {
 		check.GreaterThanOrEqual(t, 2, 1)
 		check.GreaterThanOrEqual(t, 1, 1)
 
-		mt := &common.MockT{}
-		check.GreaterThan(mt, 2, 2)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.GreaterThan(t, 2, 2)
+		})
 
-		mt = &common.MockT{}
-		check.GreaterThanOrEqual(mt, 1, 2)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.GreaterThanOrEqual(t, 1, 2)
+		})
 	})
 	t.Run("string", func(t *testing.T) {
 		t.Parallel()
@@ -333,15 +344,13 @@ func TestGreater(t *testing.T) {
 		check.GreaterThanOrEqual(t, "bbb", "aaa")
 		check.GreaterThanOrEqual(t, "bbb", "bbb")
 
-		mt := &common.MockT{}
-		check.GreaterThan(mt, "bbb", "bbb")
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.GreaterThan(t, "bbb", "bbb")
+		})
 
-		mt = &common.MockT{}
-		check.GreaterThanOrEqual(mt, "aaa", "bbb")
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.GreaterThanOrEqual(t, "aaa", "bbb")
+		})
 	})
 	t.Run("rune", func(t *testing.T) {
 		t.Parallel()
@@ -349,15 +358,13 @@ func TestGreater(t *testing.T) {
 		check.GreaterThanOrEqual(t, 'b', 'a')
 		check.GreaterThanOrEqual(t, 'b', 'b')
 
-		mt := &common.MockT{}
-		check.GreaterThan(mt, 'b', 'b')
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.GreaterThan(t, 'b', 'b')
+		})
 
-		mt = &common.MockT{}
-		check.GreaterThanOrEqual(mt, 'a', 'b')
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.GreaterThanOrEqual(t, 'a', 'b')
+		})
 	})
 }
 
@@ -367,59 +374,178 @@ func TestError(t *testing.T) {
 		t.Parallel()
 		check.Error(t, fmt.Errorf("new error"))
 
-		mt := &common.MockT{}
-		check.Error(mt, nil)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Error(t, nil)
+		})
 	})
 	t.Run("nil", func(t *testing.T) {
 		t.Parallel()
 		check.Nil(t, nil)
 
-		mt := &common.MockT{}
-		check.Nil(mt, fmt.Errorf("new error"))
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Nil(t, fmt.Errorf("new error"))
+		})
 	})
 	t.Run("noerror", func(t *testing.T) {
 		t.Parallel()
 		check.NoError(t, nil)
 
+		testytest.ExpectFail(t, func(t common.T) {
+			check.NoError(t, fmt.Errorf("new error"))
+		})
+	})
+}
+
+var errNotFound = errors.New("not found")
+
+type codeError struct {
+	Code int
+}
+
+func (e *codeError) Error() string {
+	return fmt.Sprintf("code %d", e.Code)
+}
+
+type multiError []error
+
+func (e multiError) Error() string {
+	return errors.Join(e...).Error()
+}
+
+func (e multiError) Unwrap() []error {
+	return e
+}
+
+func TestErrorIs(t *testing.T) {
+	t.Parallel()
+	t.Run("wrapped", func(t *testing.T) {
+		t.Parallel()
+		check.ErrorIs(t, errNotFound, errNotFound)
+		check.ErrorIs(t, fmt.Errorf("loading user: %w", errNotFound), errNotFound)
+
+		testytest.ExpectFail(t, func(t common.T) {
+			check.ErrorIs(t, fmt.Errorf("loading user: %v", errNotFound), errNotFound)
+		})
+	})
+	t.Run("joined", func(t *testing.T) {
+		t.Parallel()
+		check.ErrorIs(t, errors.Join(errors.New("other"), errNotFound), errNotFound)
+		check.ErrorIs(t, multiError{errors.New("other"), errNotFound}, errNotFound)
+
+		testytest.ExpectFail(t, func(t common.T) {
+			check.ErrorIs(t, errors.Join(errors.New("other")), errNotFound)
+		})
+	})
+	t.Run("error tree", func(t *testing.T) {
+		t.Parallel()
+		err := fmt.Errorf("loading user: %w", errors.Join(
+			errors.New("timeout"),
+			errors.Join(errors.New("a"), errors.New("b")),
+			fmt.Errorf("retrying: %w", multiError{errNotFound, &codeError{Code: 500}}),
+		))
 		mt := &common.MockT{}
-		check.NoError(mt, fmt.Errorf("new error"))
+		check.False(t, check.ErrorIs(mt, err, errors.New("target")))
+		if messages := mt.Messages(); check.Equal(t, 1, len(messages)) {
+			check.Equal(t, strings.Join([]string{
+				"expected errors.Is(err, target)",
+				`target: &errors.errorString{s:"target"}`,
+				`   err: *fmt.wrapError: "loading user: timeout\na\nb\nretrying: not found\ncode 500"`,
+				`        └── *errors.joinError: "timeout\na\nb\nretrying: not found\ncode 500"`,
+				`            ├── *errors.errorString: "timeout"`,
+				`            ├── *errors.joinError: "a\nb"`,
+				`            │   ├── *errors.errorString: "a"`,
+				`            │   └── *errors.errorString: "b"`,
+				`            └── *fmt.wrapError: "retrying: not found\ncode 500"`,
+				`                └── check_test.multiError: "not found\ncode 500"`,
+				`                    ├── *errors.errorString: "not found"`,
+				`                    └── *check_test.codeError: "code 500"`,
+			}, "\n"), messages[0].Text)
+		}
+	})
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+		check.ErrorIs(t, nil, nil)
+
+		testytest.ExpectFail(t, func(t common.T) {
+			check.ErrorIs(t, nil, errNotFound)
+		})
+	})
+}
+
+func TestErrorAs(t *testing.T) {
+	t.Parallel()
+	t.Run("wrapped", func(t *testing.T) {
+		t.Parallel()
+		err := fmt.Errorf("request failed: %w", &codeError{Code: 404})
+		target, ok := check.ErrorAs[*codeError](t, err)
+		check.True(t, ok)
+		if check.NotNil(t, target) {
+			check.Equal(t, 404, target.Code)
+		}
+
+		mt := &common.MockT{}
+		target, ok = check.ErrorAs[*codeError](mt, errNotFound)
+		check.False(t, ok)
+		check.Nil(t, target)
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	})
+	t.Run("joined", func(t *testing.T) {
+		t.Parallel()
+		err := errors.Join(errNotFound, fmt.Errorf("wrapped: %w", &codeError{Code: 500}))
+		target, ok := check.ErrorAs[*codeError](t, err)
+		check.True(t, ok)
+		check.Equal(t, &codeError{Code: 500}, target)
+	})
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+		mt := &common.MockT{}
+		_, ok := check.ErrorAs[*codeError](mt, nil)
+		check.False(t, ok)
 		check.True(t, mt.Failed())
 		check.False(t, mt.FailedNow())
 	})
 }
 
+func TestErrorContains(t *testing.T) {
+	t.Parallel()
+	check.ErrorContains(t, fmt.Errorf("loading user: %w", errNotFound), "not found")
+	check.ErrorContains(t, errNotFound, "")
+
+	testytest.ExpectFail(t, func(t common.T) {
+		check.ErrorContains(t, errNotFound, "timeout")
+	})
+
+	testytest.ExpectFail(t, func(t common.T) {
+		check.ErrorContains(t, nil, "")
+	})
+}
+
 func TestIn(t *testing.T) {
 	t.Parallel()
 	t.Run("int", func(t *testing.T) {
 		t.Parallel()
 		check.In(t, 1, []int{1, 2, 3})
 
-		mt := &common.MockT{}
-		check.In(mt, 1, []int{4, 5, 6})
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.In(t, 1, []int{4, 5, 6})
+		})
 	})
 	t.Run("nil equality", func(t *testing.T) {
 		t.Parallel()
 		check.In(t, nil, []any{nil})
 
-		mt := &common.MockT{}
-		check.In(mt, nil, []any{})
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.In(t, nil, []any{})
+		})
 	})
 	t.Run("strings", func(t *testing.T) {
 		t.Parallel()
 		check.In(t, "world", []string{"hello", "world"})
 
-		mt := &common.MockT{}
-		check.In(mt, "world", []string{"hello world"})
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.In(t, "world", []string{"hello world"})
+		})
 	})
 	t.Run("hidden struct fields with cmp.opts", func(t *testing.T) {
 		t.Parallel()
@@ -435,17 +561,16 @@ func TestIn(t *testing.T) {
 			cmp.AllowUnexported(hiddenPerson{}),
 		)
 
-		mt := &common.MockT{}
-		check.In(
-			mt,
-			hiddenPerson{Name: "Peter", hidden: true},
-			[]hiddenPerson{
-				{Name: "Peter", hidden: false},
-			},
-			cmp.AllowUnexported(hiddenPerson{}),
-		)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.In(
+				t,
+				hiddenPerson{Name: "Peter", hidden: true},
+				[]hiddenPerson{
+					{Name: "Peter", hidden: false},
+				},
+				cmp.AllowUnexported(hiddenPerson{}),
+			)
+		})
 	})
 
 	t.Run("time.time structs with custom .equals", func(t *testing.T) {
@@ -454,30 +579,27 @@ func TestIn(t *testing.T) {
 		t2 := t1.UTC()
 		check.In(t, &t1, []*time.Time{nil, &t2})
 
-		mt := &common.MockT{}
-		check.In(
-			mt,
-			t1,
-			[]time.Time{
-				t1.Add(1 * time.Hour),
-			},
-			cmp.AllowUnexported(hiddenPerson{}),
-		)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.In(
+				t,
+				t1,
+				[]time.Time{
+					t1.Add(1 * time.Hour),
+				},
+				cmp.AllowUnexported(hiddenPerson{}),
+			)
+		})
 	})
 
 	t.Run("empty slice", func(t *testing.T) {
 		t.Parallel()
-		mt := &common.MockT{}
-		check.In(mt, 1, []int{})
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.In(t, 1, []int{})
+		})
 
-		mt = &common.MockT{}
-		check.In(mt, 1, nil)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.In(t, 1, nil)
+		})
 	})
 }
 
@@ -509,10 +631,9 @@ func TestNotIn(t *testing.T) {
 		t.Parallel()
 		check.NotIn(t, "world", []string{"hello world"})
 
-		mt := &common.MockT{}
-		check.NotIn(mt, "world", []string{"hello", "world"})
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.NotIn(t, "world", []string{"hello", "world"})
+		})
 	})
 	t.Run("hidden struct fields with cmp.opts", func(t *testing.T) {
 		t.Parallel()
@@ -528,17 +649,16 @@ func TestNotIn(t *testing.T) {
 			cmp.AllowUnexported(hiddenPerson{}),
 		)
 
-		mt := &common.MockT{}
-		check.NotIn(
-			mt,
-			customStructWithHiddenField,
-			[]hiddenPerson{
+		testytest.ExpectFail(t, func(t common.T) {
+			check.NotIn(
+				t,
 				customStructWithHiddenField,
-			},
-			cmp.AllowUnexported(hiddenPerson{}),
-		)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+				[]hiddenPerson{
+					customStructWithHiddenField,
+				},
+				cmp.AllowUnexported(hiddenPerson{}),
+			)
+		})
 	})
 
 	t.Run("time.time structs with custom .equals", func(t *testing.T) {
@@ -552,11 +672,10 @@ func TestNotIn(t *testing.T) {
 			},
 		)
 
-		mt := &common.MockT{}
-		t2 := t1.UTC()
-		check.NotIn(mt, t1, []time.Time{t1, t2})
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			t2 := t1.UTC()
+			check.NotIn(t, t1, []time.Time{t1, t2})
+		})
 	})
 
 	t.Run("empty slice", func(t *testing.T) {
@@ -566,6 +685,134 @@ func TestNotIn(t *testing.T) {
 	})
 }
 
+func TestElementsMatch(t *testing.T) {
+	t.Parallel()
+	t.Run("int", func(t *testing.T) {
+		t.Parallel()
+		check.ElementsMatch(t, []int{1, 2, 3}, []int{3, 1, 2})
+		check.ElementsMatch(t, []int{1, 1, 2}, []int{1, 2, 1})
+		check.ElementsMatch(t, []int{}, nil)
+
+		for _, got := range [][]int{
+			{1, 2},
+			{1, 2, 3, 3},
+			{1, 2, 4},
+			{1, 1, 2},
+			nil,
+		} {
+			mt := &common.MockT{}
+			check.False(t, check.ElementsMatch(mt, []int{1, 2, 3}, got))
+			check.True(t, mt.Failed())
+			check.False(t, mt.FailedNow())
+		}
+	})
+	t.Run("uses go-cmp equality", func(t *testing.T) {
+		t.Parallel()
+		check.ElementsMatch(t,
+			[]*person{{Name: "peter"}, {Name: "bob"}},
+			[]*person{{Name: "bob"}, {Name: "peter"}},
+		)
+
+		t1 := time.Now()
+		check.ElementsMatch(t, []time.Time{t1, t1.Add(time.Hour)}, []time.Time{t1.Add(time.Hour), t1.UTC()})
+	})
+	t.Run("approximate equality", func(t *testing.T) {
+		t.Parallel()
+		// 1.0 is close to both 1.08 and 0.95, but 1.15 is only close to
+		// 1.08, so pairing 1.0 with 1.08 first would fail.
+		approx := cmpopts.EquateApprox(0, 0.1)
+		check.ElementsMatch(t, []float64{1.0, 1.15}, []float64{1.08, 0.95}, approx)
+		check.ElementsMatch(t, []float64{1.15, 1.0}, []float64{0.95, 1.08}, approx)
+
+		mt := &common.MockT{}
+		check.False(t, check.ElementsMatch(mt, []float64{1.0, 1.15}, []float64{1.3, 0.95}, approx))
+		if check.Equal(t, 1, len(mt.Messages())) {
+			check.Contains(t, "missing from got: []float64{1.15}\n   extra in got: []float64{1.3}", mt.Messages()[0].Text)
+		}
+	})
+	t.Run("hidden struct fields with cmp.opts", func(t *testing.T) {
+		t.Parallel()
+		check.ElementsMatch(t,
+			[]hiddenPerson{{Name: "peter", hidden: true}, {Name: "peter"}},
+			[]hiddenPerson{{Name: "peter"}, {Name: "peter", hidden: true}},
+			cmp.AllowUnexported(hiddenPerson{}),
+		)
+
+		testytest.ExpectFail(t, func(t common.T) {
+			check.ElementsMatch(t,
+				[]hiddenPerson{{Name: "peter", hidden: true}, {Name: "peter"}},
+				[]hiddenPerson{{Name: "peter"}, {Name: "peter"}},
+				cmp.AllowUnexported(hiddenPerson{}),
+			)
+		})
+	})
+}
+
+func TestSubset(t *testing.T) {
+	t.Parallel()
+	check.Subset(t, []int{1, 2, 3}, []int{3, 1})
+	check.Subset(t, []int{1, 2, 3}, []int{1, 1, 1})
+	check.Subset(t, []int{1, 2, 3}, nil)
+
+	mt := &common.MockT{}
+	check.False(t, check.Subset(mt, []int{1, 2, 3}, []int{1, 4, 5}))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+
+	testytest.ExpectFail(t, func(t common.T) {
+		check.Subset(t,
+			[]hiddenPerson{{Name: "peter"}},
+			[]hiddenPerson{{Name: "peter", hidden: true}},
+			cmp.AllowUnexported(hiddenPerson{}),
+		)
+	})
+}
+
+func TestSuperset(t *testing.T) {
+	t.Parallel()
+	check.Superset(t, []int{3, 1}, []int{1, 2, 3})
+	check.Superset(t, nil, []int{1, 2, 3})
+	check.Superset(t, []*person{{Name: "peter"}}, []*person{{Name: "bob"}, {Name: "peter"}})
+
+	mt := &common.MockT{}
+	check.False(t, check.Superset(mt, []int{1, 4, 5}, []int{1, 2, 3}))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
+
+func TestMapContains(t *testing.T) {
+	t.Parallel()
+	got := map[string]int{"a": 1, "b": 2, "c": 3}
+	check.MapContains(t, map[string]int{"a": 1, "c": 3}, got)
+	check.MapContains(t, map[string]int{}, got)
+	check.MapContains(t, nil, got)
+
+	for _, want := range []map[string]int{
+		{"a": 1, "d": 4},
+		{"a": 2},
+		{"a": 1, "b": 3, "z": 26},
+	} {
+		mt := &common.MockT{}
+		check.False(t, check.MapContains(mt, want, got))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	}
+
+	t.Run("hidden struct fields with cmp.opts", func(t *testing.T) {
+		t.Parallel()
+		people := map[int]hiddenPerson{1: {Name: "peter", hidden: true}}
+		check.MapContains(t, people, people, cmp.AllowUnexported(hiddenPerson{}))
+
+		testytest.ExpectFail(t, func(t common.T) {
+			check.MapContains(t,
+				map[int]hiddenPerson{1: {Name: "peter"}},
+				people,
+				cmp.AllowUnexported(hiddenPerson{}),
+			)
+		})
+	})
+}
+
 func TestNil(t *testing.T) {
 	t.Parallel()
 
@@ -578,10 +825,9 @@ func TestNil(t *testing.T) {
 		val = fmt.Errorf("new error")
 		check.True(t, val != nil)
 
-		mt := &common.MockT{}
-		check.Nil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Nil(t, val)
+		})
 	})
 
 	t.Run("chan", func(t *testing.T) {
@@ -593,10 +839,9 @@ func TestNil(t *testing.T) {
 		val = make(chan int)
 		check.True(t, val != nil)
 
-		mt := &common.MockT{}
-		check.Nil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Nil(t, val)
+		})
 	})
 
 	t.Run("func", func(t *testing.T) {
@@ -608,10 +853,9 @@ func TestNil(t *testing.T) {
 		val = func() {}
 		check.True(t, val != nil)
 
-		mt := &common.MockT{}
-		check.Nil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Nil(t, val)
+		})
 	})
 
 	t.Run("interface", func(t *testing.T) {
@@ -623,10 +867,9 @@ func TestNil(t *testing.T) {
 		val = any("hello")        //nolint:staticcheck // intentional
 		check.True(t, val != nil) //nolint:staticcheck // intentional
 
-		mt := &common.MockT{}
-		check.Nil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Nil(t, val)
+		})
 	})
 
 	t.Run("map", func(t *testing.T) {
@@ -638,10 +881,9 @@ func TestNil(t *testing.T) {
 		val = map[string]int{}
 		check.True(t, val != nil)
 
-		mt := &common.MockT{}
-		check.Nil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Nil(t, val)
+		})
 	})
 
 	t.Run("pointer", func(t *testing.T) {
@@ -654,10 +896,9 @@ func TestNil(t *testing.T) {
 		val = &wrapped
 		check.True(t, val != nil)
 
-		mt := &common.MockT{}
-		check.Nil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Nil(t, val)
+		})
 	})
 
 	t.Run("slice", func(t *testing.T) {
@@ -670,19 +911,17 @@ func TestNil(t *testing.T) {
 		val = []int{}
 		check.True(t, val != nil)
 
-		mt := &common.MockT{}
-		check.Nil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Nil(t, val)
+		})
 
 		// full slice
 		val = []int{1, 2, 3, 4, 5}
 		check.True(t, val != nil)
 
-		mt = &common.MockT{}
-		check.Nil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Nil(t, val)
+		})
 	})
 
 	t.Run("unsafe pointer", func(t *testing.T) {
@@ -695,10 +934,9 @@ func TestNil(t *testing.T) {
 		val = unsafe.Pointer(&wrapped)
 		check.True(t, val != nil)
 
-		mt := &common.MockT{}
-		check.Nil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Nil(t, val)
+		})
 	})
 }
 
@@ -711,11 +949,10 @@ func TestNotNil(t *testing.T) {
 		check.True(t, val != nil)
 		check.NotNil(t, val)
 
-		mt := &common.MockT{}
-		val = nil
-		check.NotNil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			val = nil
+			check.NotNil(t, val)
+		})
 	})
 
 	t.Run("error", func(t *testing.T) {
@@ -724,11 +961,10 @@ func TestNotNil(t *testing.T) {
 		check.True(t, val != nil)
 		check.NotNil(t, val)
 
-		mt := &common.MockT{}
-		val = nil
-		check.NotNil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			val = nil
+			check.NotNil(t, val)
+		})
 	})
 
 	t.Run("func", func(t *testing.T) {
@@ -737,11 +973,10 @@ func TestNotNil(t *testing.T) {
 		check.True(t, val != nil)
 		check.NotNil(t, val)
 
-		mt := &common.MockT{}
-		val = nil
-		check.NotNil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			val = nil
+			check.NotNil(t, val)
+		})
 	})
 
 	t.Run("interface", func(t *testing.T) {
@@ -750,11 +985,10 @@ func TestNotNil(t *testing.T) {
 		check.True(t, val != nil) //nolint:staticcheck // intentional
 		check.NotNil(t, val)
 
-		mt := &common.MockT{}
-		val = nil
-		check.NotNil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			val = nil
+			check.NotNil(t, val)
+		})
 	})
 
 	t.Run("map", func(t *testing.T) {
@@ -763,11 +997,10 @@ func TestNotNil(t *testing.T) {
 		check.True(t, val != nil)
 		check.NotNil(t, val)
 
-		mt := &common.MockT{}
-		val = nil
-		check.NotNil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			val = nil
+			check.NotNil(t, val)
+		})
 	})
 
 	t.Run("pointer", func(t *testing.T) {
@@ -777,11 +1010,10 @@ func TestNotNil(t *testing.T) {
 		check.True(t, val != nil)
 		check.NotNil(t, val)
 
-		mt := &common.MockT{}
-		val = nil
-		check.NotNil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			val = nil
+			check.NotNil(t, val)
+		})
 	})
 
 	t.Run("slice", func(t *testing.T) {
@@ -798,10 +1030,9 @@ func TestNotNil(t *testing.T) {
 
 		// nil
 		val = nil
-		mt := &common.MockT{}
-		check.NotNil(mt, val)
-		check.True(t, mt.Failed())
-		check.False(t, mt.FailedNow())
+		testytest.ExpectFail(t, func(t common.T) {
+			check.NotNil(t, val)
+		})
 	})
 
 	t.Run("unsafe pointer", func(t *testing.T) {
@@ -812,8 +1043,153 @@ func TestNotNil(t *testing.T) {
 		check.NotNil(t, val)
 
 		val = nil
+		testytest.ExpectFail(t, func(t common.T) {
+			check.NotNil(t, val)
+		})
+	})
+}
+
+func TestZero(t *testing.T) {
+	t.Parallel()
+	t.Run("int", func(t *testing.T) {
+		t.Parallel()
+		check.Zero(t, 0)
+
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Zero(t, 1)
+		})
+	})
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+		var err error
+		check.Zero(t, err)
+
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Zero(t, fmt.Errorf("new error"))
+		})
+	})
+	t.Run("non-comparable structs", func(t *testing.T) {
+		t.Parallel()
+		type withSlice struct {
+			Values []int
+		}
+		check.Zero(t, withSlice{})
+
+		testytest.ExpectFail(t, func(t common.T) {
+			check.Zero(t, withSlice{Values: []int{}})
+		})
+	})
+}
+
+func TestNotZero(t *testing.T) {
+	t.Parallel()
+	t.Run("string", func(t *testing.T) {
+		t.Parallel()
+		check.NotZero(t, "hello")
+
+		testytest.ExpectFail(t, func(t common.T) {
+			check.NotZero(t, "")
+		})
+	})
+	t.Run("time.Time", func(t *testing.T) {
+		t.Parallel()
+		check.NotZero(t, time.Now())
+
+		testytest.ExpectFail(t, func(t common.T) {
+			check.NotZero(t, time.Time{})
+		})
+	})
+}
+
+func seqOf(values ...int) func(yield func(int) bool) {
+	return func(yield func(int) bool) {
+		for _, v := range values {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func naturals(yield func(int, int) bool) {
+	for i := 0; ; i++ {
+		if !yield(i, i+1) {
+			return
+		}
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	t.Parallel()
+	t.Run("empty values", func(t *testing.T) {
+		t.Parallel()
+		var nilSlice []int
+		var nilSeq func(yield func(int) bool)
+		check.Empty(t, nil)
+		check.Empty(t, nilSlice)
+		check.Empty(t, []int{})
+		check.Empty(t, map[string]int{})
+		check.Empty(t, "")
+		check.Empty(t, make(chan int, 1))
+		check.Empty(t, [0]int{})
+		check.Empty(t, seqOf())
+		check.Empty(t, nilSeq)
+	})
+	t.Run("non-empty values", func(t *testing.T) {
+		t.Parallel()
+		for _, val := range []any{
+			[]int{1},
+			map[string]int{"hello": 1},
+			"hello",
+			[1]int{},
+			seqOf(1, 2, 3),
+			naturals,
+		} {
+			mt := &common.MockT{}
+			check.False(t, check.Empty(mt, val))
+			check.True(t, mt.Failed())
+			check.False(t, mt.FailedNow())
+		}
+	})
+	t.Run("values without a length", func(t *testing.T) {
+		t.Parallel()
+		for _, val := range []any{1, person{}, func() {}} {
+			mt := &common.MockT{}
+			check.False(t, check.Empty(mt, val))
+			check.True(t, mt.Failed())
+			check.False(t, mt.FailedNow())
+		}
+	})
+}
+
+func TestNotEmpty(t *testing.T) {
+	t.Parallel()
+	t.Run("non-empty values", func(t *testing.T) {
+		t.Parallel()
+		ch := make(chan int, 1)
+		ch <- 1
+		check.NotEmpty(t, []int{1})
+		check.NotEmpty(t, map[string]int{"hello": 1})
+		check.NotEmpty(t, "hello")
+		check.NotEmpty(t, ch)
+		check.NotEmpty(t, [1]int{})
+		check.NotEmpty(t, seqOf(1, 2, 3))
+		check.NotEmpty(t, naturals)
+	})
+	t.Run("empty values", func(t *testing.T) {
+		t.Parallel()
+		var nilMap map[string]int
+		for _, val := range []any{nil, nilMap, []int{}, "", seqOf()} {
+			mt := &common.MockT{}
+			check.False(t, check.NotEmpty(mt, val))
+			check.True(t, mt.Failed())
+			check.False(t, mt.FailedNow())
+		}
+	})
+	t.Run("values without a length", func(t *testing.T) {
+		t.Parallel()
 		mt := &common.MockT{}
-		check.NotNil(mt, val)
+		check.False(t, check.NotEmpty(mt, 1))
 		check.True(t, mt.Failed())
 		check.False(t, mt.FailedNow())
 	})
@@ -842,4 +1218,70 @@ func TestFailureMessages(t *testing.T) {
 	check.NotNil(t, nilm)
 	nilm = map[string]string{"hello": "world"}
 	check.Nil(t, nilm)
+
+	check.Zero(t, person{Name: "peter"})
+	check.NotZero(t, "")
+	check.Empty(t, make([]int, 1000))
+	check.Empty(t, naturals)
+	check.NotEmpty(t, map[string]int{})
+
+	check.ErrorIs(t, fmt.Errorf("loading user: %w", errors.Join(
+		errors.New("timeout"),
+		fmt.Errorf("retrying: %w", &codeError{Code: 500}),
+	)), errNotFound)
+	check.ErrorAs[*codeError](t, multiError{errNotFound, errors.New("other")})
+	check.ErrorContains(t, errNotFound, "timeout")
+
+	check.Eventually(t, func() bool { return false }, 10*time.Millisecond, time.Millisecond)
+	check.EventuallyWith(t, func(t common.T) {
+		check.Equal(t, "ready", "starting")
+	}, 10*time.Millisecond, time.Millisecond)
+	check.Consistently(t, func() bool { return false }, 10*time.Millisecond, time.Millisecond)
+	check.ConsistentlyWith(t, func(t common.T) {
+		check.True(t, false)
+	}, 10*time.Millisecond, time.Millisecond)
+
+	check.Panics(t, func() {})
+	check.NotPanics(t, func() { panic("oh no") })
+	check.PanicsWith(t, person{Name: "peter"}, func() { panic(person{Name: "bob"}) })
+	check.PanicsWith(t, "oh no", func() { panic(errNotFound) })
+
+	check.JSONEqual(t,
+		[]byte(`{"name": "peter", "pets": [{"name": "fido"}, {"name": "rex"}], "meta": {"requestId": "abc"}}`),
+		[]byte(`{"name": "bob", "pets": [{"name": "fido", "age": 3}], "meta": {"requestId": "xyz"}}`),
+		check.JSONIgnorePaths("$.meta.requestId"),
+	)
+	check.ElementsMatch(t, []string{"a", "b", "b", "c"}, []string{"c", "b", "d", "a"})
+	check.Subset(t, []int{1, 2, 3}, []int{1, 4, 5})
+	check.Superset(t, []int{1, 4, 5}, []int{1, 2, 3})
+	check.MapContains(t,
+		map[string]int{"a": 1, "b": 2, "z": 26},
+		map[string]int{"a": 1, "b": 3, "c": 3},
+	)
+	check.InDelta(t, 1.0, 1.5, 0.1)
+	check.InEpsilon(t, 100.0, 110.0, 0.01)
+	check.WithinULP(t, 1.0, 1.0000001, 4)
+	check.InDeltaSlice(t, []float64{1, 2, 3}, []float64{1, 2.5, math.NaN()}, 0.1)
+	check.InDeltaMap(t, map[string]float64{"a": 1, "b": 2}, map[string]float64{"a": 1.5, "c": 3}, 0.1)
+	check.Before(t, cents(200), cents(199))
+	check.After(t, netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("10.0.0.2"))
+	check.InRange(t, 1, 10, 11)
+	check.InRangeFunc(t, big.NewInt(1), big.NewInt(10), big.NewInt(11), (*big.Int).Cmp)
+
+	now := time.Now()
+	check.WithinDuration(t, now, now.Add(-1500*time.Millisecond), time.Second)
+	check.TimeEqual(t, now, now.Add(time.Nanosecond).UTC())
+
+	check.Contains(t, "status: ok", "status: okay\nstatus: error")
+	check.NotContains(t, "error", []byte("status: okay\nstatus: error"))
+	check.HasPrefix(t, "/usr/lib", "/usr/bin/go")
+	check.HasSuffix(t, ".go", "main.py")
+	check.Matches(t, `^\d{3}-\d{4}$`, "555-12345")
+	check.Equal(t, "hello world", "hello wurld")
+	check.Equal(t, "one\ntwo\nthree\nfour\nfive\n", "one\ntwo\nTHREE\nfour\nfive\nsix\n")
+	check.Equal(t, []byte("line one\nline two\n"), []byte("line one\nline 2"))
+	check.That(t, []int{20, 17}, match.Each(match.Satisfies("> 18", func(age int) bool { return age > 18 })))
+	check.Go(t, func(t common.T) {
+		check.Equal(t, "done", "failed")
+	}).Wait()
 }
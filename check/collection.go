@@ -0,0 +1,120 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/peterldowns/testy/internal/preview"
+)
+
+// seqPreviewLimit is the maximum number of elements that will be consumed from
+// an iter.Seq or iter.Seq2 when determining its length. Sequences may be
+// infinite, so they are never consumed completely.
+const seqPreviewLimit = 10
+
+// collection is a reflection-based description of a value that has a length.
+type collection struct {
+	value  reflect.Value
+	length int
+	// partial is true if length is a lower bound, because a sequence was only
+	// partially consumed.
+	partial bool
+	// elements holds the formatted elements consumed from a sequence, since
+	// sequences cannot be printed directly.
+	elements []string
+}
+
+// newCollection returns a description of val, or false if val does not have a
+// length.
+func newCollection(val any) (collection, bool) {
+	if val == nil {
+		return collection{}, true
+	}
+	value := reflect.ValueOf(val)
+	switch value.Kind() {
+	case
+		reflect.Array,
+		reflect.Chan,
+		reflect.Map,
+		reflect.Slice,
+		reflect.String:
+		return collection{value: value, length: value.Len()}, true
+	case reflect.Func:
+		if !isSeq(value.Type()) {
+			return collection{}, false
+		}
+		return newSeqCollection(value), true
+	default:
+		return collection{}, false
+	}
+}
+
+// isSeq returns true if typ has the shape of an iter.Seq or iter.Seq2:
+//
+//	func(yield func(V) bool)
+//	func(yield func(K, V) bool)
+//
+// This is detected structurally so that Testy does not need to import the
+// iter package, which requires go1.23.
+func isSeq(typ reflect.Type) bool {
+	if typ.NumIn() != 1 || typ.NumOut() != 0 {
+		return false
+	}
+	yield := typ.In(0)
+	if yield.Kind() != reflect.Func {
+		return false
+	}
+	if yield.NumIn() != 1 && yield.NumIn() != 2 {
+		return false
+	}
+	return yield.NumOut() == 1 && yield.Out(0).Kind() == reflect.Bool
+}
+
+// newSeqCollection consumes up to seqPreviewLimit elements from a sequence.
+func newSeqCollection(seq reflect.Value) collection {
+	c := collection{value: seq}
+	if seq.IsNil() {
+		return c
+	}
+	yieldType := seq.Type().In(0)
+	yield := reflect.MakeFunc(yieldType, func(args []reflect.Value) []reflect.Value {
+		if c.length == seqPreviewLimit {
+			c.partial = true
+			return []reflect.Value{reflect.ValueOf(false)}
+		}
+		c.length++
+		parts := make([]string, 0, len(args))
+		for _, arg := range args {
+			parts = append(parts, fmt.Sprintf("%#v", arg.Interface()))
+		}
+		c.elements = append(c.elements, strings.Join(parts, ": "))
+		return []reflect.Value{reflect.ValueOf(true)}
+	})
+	seq.Call([]reflect.Value{yield})
+	return c
+}
+
+// describeLength formats the length of the collection.
+func (c collection) describeLength() string {
+	if c.partial {
+		return fmt.Sprintf("at least %d", c.length)
+	}
+	return fmt.Sprintf("%d", c.length)
+}
+
+// preview formats the contents of the collection, truncated to
+// preview.MaxLength.
+func (c collection) preview() string {
+	if !c.value.IsValid() {
+		return preview.Value(nil)
+	}
+	if c.value.Kind() != reflect.Func {
+		return preview.Value(c.value.Interface())
+	}
+	elements := c.elements
+	if c.partial {
+		elements = append(elements, "...")
+	}
+	return preview.Text(fmt.Sprintf("%s{%s}", c.value.Type(), strings.Join(elements, ", ")))
+}
@@ -0,0 +1,33 @@
+package check
+
+import (
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/report"
+)
+
+// Define turns a condition into a check, so that you can write your own
+// helpers without calling t.Error yourself. fn returns whether got passes,
+// and a message explaining why it failed:
+//
+//	var IsAdult = check.Define(func(u User) (bool, string) {
+//		return u.Age >= 18, fmt.Sprintf("expected an adult, got age %d", u.Age)
+//	})
+//
+//	IsAdult(t, user)
+//
+// The message is ignored if got passes. Pass the check to assert.Must to get
+// a variant that stops the test when it fails.
+func Define[T any](fn func(got T) (ok bool, msg string)) func(t common.T, got T) bool {
+	return func(t common.T, got T) bool {
+		t.Helper()
+		ok, msg := fn(got)
+		if ok {
+			return true
+		}
+		report.Report(t, report.Failure{
+			Message: msg,
+			Got:     got,
+		})
+		return false
+	}
+}
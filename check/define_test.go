@@ -0,0 +1,32 @@
+package check_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+var isEven = check.Define(func(n int) (bool, string) {
+	return n%2 == 0, fmt.Sprintf("expected an even number, got %d", n)
+})
+
+func TestDefine(t *testing.T) {
+	t.Parallel()
+	check.True(t, isEven(t, 4))
+
+	mt := &common.MockT{}
+	_, _, line, _ := runtime.Caller(0)
+	check.False(t, isEven(mt, 3))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+	check.Equal(t, []string{"expected an even number, got 3"}, mt.Errors())
+	// Failures are reported at the line that called the defined check.
+	if messages := mt.Messages(); check.Equal(t, 1, len(messages)) {
+		check.Equal(t, "define_test.go", filepath.Base(messages[0].File))
+		check.Equal(t, line+1, messages[0].Line)
+	}
+}
@@ -0,0 +1,57 @@
+package check
+
+import (
+	"fmt"
+	"strings"
+)
+
+// errorTree formats err and every error it wraps, following both
+// `Unwrap() error` and `Unwrap() []error` (as used by errors.Join and
+// fmt.Errorf with multiple %w verbs), as a tree:
+//
+//	*fmt.wrapError: "loading user: not found"
+//	└── *errors.errorString: "not found"
+//
+// Every line after the first is prefixed with indent, so that the tree lines
+// up with a label in a failure message.
+func errorTree(err error, indent string) string {
+	if err == nil {
+		return "<nil>"
+	}
+	var b strings.Builder
+	writeErrorTree(&b, err, "", indent)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// writeErrorTree writes a single error to b, followed by its children. prefix
+// is written before this error; childPrefix is written before each of its
+// children.
+func writeErrorTree(b *strings.Builder, err error, prefix, childPrefix string) {
+	b.WriteString(prefix)
+	if err == nil {
+		b.WriteString("<nil>\n")
+		return
+	}
+	fmt.Fprintf(b, "%T: %q\n", err, err.Error())
+	children := unwrapAll(err)
+	for i, child := range children {
+		if i == len(children)-1 {
+			writeErrorTree(b, child, childPrefix+"└── ", childPrefix+"    ")
+		} else {
+			writeErrorTree(b, child, childPrefix+"├── ", childPrefix+"│   ")
+		}
+	}
+}
+
+// unwrapAll returns the errors directly wrapped by err.
+func unwrapAll(err error) []error {
+	switch x := err.(type) { //nolint:errorlint // intentionally inspecting only err itself
+	case interface{ Unwrap() error }:
+		if inner := x.Unwrap(); inner != nil {
+			return []error{inner}
+		}
+	case interface{ Unwrap() []error }:
+		return x.Unwrap()
+	}
+	return nil
+}
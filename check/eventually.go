@@ -0,0 +1,312 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/report"
+)
+
+// Eventually passes and returns true if condition returns true within timeout.
+// The condition is called immediately and then once every interval until it
+// returns true or timeout elapses.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// If t has a Deadline() method, like *testing.T, the timeout is capped at the
+// test's deadline. An attempt that is still running when time runs out counts
+// as a failure, and is left running in the background. interval must be
+// positive.
+func Eventually(t common.T, condition func() bool, timeout time.Duration, interval time.Duration) bool {
+	t.Helper()
+	if !positiveInterval(t, interval) {
+		return false
+	}
+	attempts, last, ok := eventually(t, conditionAttempt(condition), timeout, interval)
+	if ok {
+		return true
+	}
+	report.Report(t, report.Failure{Message: fmt.Sprintf("expected condition to return true within %s\nattempts: %d%s", timeout, attempts, last.stillRunning())})
+	return false
+}
+
+// EventuallyWith passes and returns true if an attempt of fn passes within
+// timeout. Each attempt receives its own common.T, so normal checks and
+// asserts can be used inside of fn, and an attempt passes if none of them
+// fail. The first attempt is made immediately and then once every interval
+// until one passes or timeout elapses.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes the
+// failure messages from the last attempt.
+//
+// If t has a Deadline() method, like *testing.T, the timeout is capped at the
+// test's deadline. An attempt that is still running when time runs out counts
+// as a failure, and is left running in the background. interval must be
+// positive.
+func EventuallyWith(t common.T, fn func(t common.T), timeout time.Duration, interval time.Duration) bool {
+	t.Helper()
+	if !positiveInterval(t, interval) {
+		return false
+	}
+	attempts, last, ok := eventually(t, fn, timeout, interval)
+	if ok {
+		return true
+	}
+	report.Report(t, report.Failure{Message: fmt.Sprintf("expected an attempt to pass within %s\nattempts: %d%s\nlast attempt:\n%s", timeout, attempts, last.stillRunning(), last.report())})
+	return false
+}
+
+// Consistently passes and returns true if condition returns true every time it
+// is called during duration. The condition is called immediately and then once
+// every interval until it returns false or duration elapses.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// If t has a Deadline() method, like *testing.T, the duration is capped at the
+// test's deadline. An attempt that is still running when time runs out is
+// ignored, and left running in the background. interval must be positive.
+func Consistently(t common.T, condition func() bool, duration time.Duration, interval time.Duration) bool {
+	t.Helper()
+	if !positiveInterval(t, interval) {
+		return false
+	}
+	attempts, _, ok := consistently(t, conditionAttempt(condition), duration, interval)
+	if ok {
+		return true
+	}
+	report.Report(t, report.Failure{Message: fmt.Sprintf("expected condition to return true for %s\nfailed on attempt: %d", duration, attempts)})
+	return false
+}
+
+// ConsistentlyWith passes and returns true if every attempt of fn during
+// duration passes. Each attempt receives its own common.T, so normal checks
+// and asserts can be used inside of fn, and an attempt passes if none of them
+// fail. The first attempt is made immediately and then once every interval
+// until one fails or duration elapses.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes the
+// failure messages from the failing attempt.
+//
+// If t has a Deadline() method, like *testing.T, the duration is capped at the
+// test's deadline. An attempt that is still running when time runs out is
+// ignored, and left running in the background. interval must be positive.
+func ConsistentlyWith(t common.T, fn func(t common.T), duration time.Duration, interval time.Duration) bool {
+	t.Helper()
+	if !positiveInterval(t, interval) {
+		return false
+	}
+	attempts, last, ok := consistently(t, fn, duration, interval)
+	if ok {
+		return true
+	}
+	report.Report(t, report.Failure{Message: fmt.Sprintf("expected every attempt to pass for %s\nfailed on attempt: %d\nfailing attempt:\n%s", duration, attempts, last.report())})
+	return false
+}
+
+// eventually makes attempts until one passes or the timeout elapses, even if
+// an attempt is still running. It returns the number of attempts made, the
+// last attempt, and whether or not it passed.
+func eventually(t common.T, fn func(common.T), timeout, interval time.Duration) (int, *attemptT, bool) {
+	deadline := pollDeadline(t, timeout)
+	// Unlike a timer's channel, ctx.Done() stays closed once time runs out, so
+	// runAttempt can't use up the signal.
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for attempts := 1; ; attempts++ {
+		last := runAttempt(fn, ctx.Done())
+		if last.running {
+			return attempts, last, false
+		}
+		if !last.Failed() {
+			return attempts, last, true
+		}
+		select {
+		case <-ctx.Done():
+			return attempts, last, false
+		case <-ticker.C:
+			// Time may have run out as well, and select picks at random.
+			// The context can take a moment to notice, so check the clock.
+			if !time.Now().Before(deadline) {
+				return attempts, last, false
+			}
+		}
+	}
+}
+
+// consistently makes attempts until one fails or the duration elapses, even
+// if an attempt is still running. It returns the number of attempts made, the
+// last attempt, and whether or not every attempt passed.
+func consistently(t common.T, fn func(common.T), duration, interval time.Duration) (int, *attemptT, bool) {
+	deadline := pollDeadline(t, duration)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for attempts := 1; ; attempts++ {
+		last := runAttempt(fn, ctx.Done())
+		if last.running {
+			return attempts, last, true
+		}
+		if last.Failed() {
+			return attempts, last, false
+		}
+		select {
+		case <-ctx.Done():
+			return attempts, last, true
+		case <-ticker.C:
+			// Time may have run out as well, and select picks at random.
+			// The context can take a moment to notice, so check the clock.
+			if !time.Now().Before(deadline) {
+				return attempts, last, true
+			}
+		}
+	}
+}
+
+// conditionAttempt adapts a boolean condition to an attempt.
+func conditionAttempt(condition func() bool) func(common.T) {
+	return func(t common.T) {
+		if !condition() {
+			t.Fail()
+		}
+	}
+}
+
+// deadliner is implemented by *testing.T.
+type deadliner interface {
+	Deadline() (time.Time, bool)
+}
+
+// pollDeadline returns the time at which polling should stop, which is after
+// timeout or at the test's deadline, whichever is sooner.
+func pollDeadline(t common.T, timeout time.Duration) time.Time {
+	deadline := time.Now().Add(timeout)
+	if d, ok := report.Unwrap(t).(deadliner); ok {
+		if testDeadline, ok := d.Deadline(); ok && testDeadline.Before(deadline) {
+			return testDeadline
+		}
+	}
+	return deadline
+}
+
+// runAttempt calls fn on its own goroutine, so that a FailNow() inside of fn
+// only stops the attempt. If fn panics, the panic is re-raised on the calling
+// goroutine. If stop is closed before fn returns, runAttempt returns the
+// attempt marked as running. There's no way to stop fn, so it keeps running in
+// the background, and anything it reports is ignored.
+func runAttempt(fn func(common.T), stop <-chan struct{}) *attemptT {
+	at := &attemptT{}
+	// The attempt's failures are only reported to the test if every attempt
+	// fails, so they must not reach reporters like report.GitHub, which
+	// report every failure they're given.
+	report.Use(at, report.Plain{})
+	var recovered any
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer report.Use(at, nil)
+		defer func() {
+			recovered = recover()
+		}()
+		fn(at)
+	}()
+	select {
+	case <-done:
+	case <-stop:
+		// fn may have returned, or be about to, just as time ran out.
+		runtime.Gosched()
+		select {
+		case <-done:
+		default:
+			at.running = true
+			return at
+		}
+	}
+	if recovered != nil {
+		panic(recovered)
+	}
+	return at
+}
+
+// positiveInterval passes and returns true if interval is positive, which
+// polling requires.
+func positiveInterval(t common.T, interval time.Duration) bool {
+	t.Helper()
+	if interval > 0 {
+		return true
+	}
+	report.Report(t, report.Failure{Message: fmt.Sprintf("expected a positive interval, received %s", interval)})
+	return false
+}
+
+// attemptT is the common.T passed to each attempt made by EventuallyWith and
+// ConsistentlyWith. It records failures instead of reporting them, so that
+// only the failures of a single attempt are reported to the test.
+type attemptT struct {
+	mu       sync.Mutex
+	failed   bool
+	messages []string
+	running  bool // fn hadn't returned when time ran out
+}
+
+func (t *attemptT) Error(args ...any) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.failed = true
+	t.messages = append(t.messages, fmt.Sprint(args...))
+}
+
+func (t *attemptT) Fail() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.failed = true
+}
+
+func (t *attemptT) FailNow() {
+	t.Fail()
+	runtime.Goexit()
+}
+
+func (*attemptT) Helper() {
+	// no-op
+}
+
+func (t *attemptT) Failed() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.failed
+}
+
+// stillRunning notes that the attempt hadn't finished when time ran out, for
+// the end of a failure message.
+func (t *attemptT) stillRunning() string {
+	if !t.running {
+		return ""
+	}
+	return "\nthe last attempt was still running when time ran out"
+}
+
+// report formats the recorded failure messages, indented.
+func (t *attemptT) report() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.messages) == 0 {
+		return "    (failed without a message)"
+	}
+	lines := make([]string, 0, len(t.messages))
+	for _, message := range t.messages {
+		lines = append(lines, "    "+strings.ReplaceAll(message, "\n", "\n    "))
+	}
+	return strings.Join(lines, "\n")
+}
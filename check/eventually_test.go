@@ -0,0 +1,217 @@
+package check_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/report"
+)
+
+// deadlineT is a common.T with a test deadline, like *testing.T.
+type deadlineT struct {
+	common.MockT
+	deadline time.Time
+}
+
+func (t *deadlineT) Deadline() (time.Time, bool) {
+	return t.deadline, true
+}
+
+func TestEventually(t *testing.T) {
+	t.Parallel()
+	t.Run("passes once the condition is true", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		res := check.Eventually(t, func() bool {
+			return calls.Add(1) == 3
+		}, time.Second, time.Millisecond)
+		check.True(t, res)
+		check.Equal(t, int32(3), calls.Load())
+	})
+	t.Run("fails after the timeout", func(t *testing.T) {
+		t.Parallel()
+		mt := &common.MockT{}
+		res := check.Eventually(mt, func() bool {
+			return false
+		}, 20*time.Millisecond, time.Millisecond)
+		check.False(t, res)
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	})
+	t.Run("timeout is capped at the test deadline", func(t *testing.T) {
+		t.Parallel()
+		mt := &deadlineT{deadline: time.Now().Add(20 * time.Millisecond)}
+		start := time.Now()
+		check.Eventually(mt, func() bool {
+			return false
+		}, time.Hour, time.Millisecond)
+		check.LessThan(t, time.Since(start), time.Minute)
+		check.True(t, mt.Failed())
+
+		// The deadline is found through annotations, too.
+		mt = &deadlineT{deadline: time.Now().Add(20 * time.Millisecond)}
+		start = time.Now()
+		check.Eventually(check.With(mt, "annotated"), func() bool {
+			return false
+		}, time.Hour, time.Millisecond)
+		check.LessThan(t, time.Since(start), time.Minute)
+		check.True(t, mt.Failed())
+	})
+}
+
+func TestEventuallyWith(t *testing.T) {
+	t.Parallel()
+	t.Run("passes once an attempt passes", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		res := check.EventuallyWith(t, func(t common.T) {
+			check.Equal(t, int32(3), calls.Add(1))
+		}, time.Second, time.Millisecond)
+		check.True(t, res)
+		check.Equal(t, int32(3), calls.Load())
+	})
+	t.Run("asserts only stop the attempt", func(t *testing.T) {
+		t.Parallel()
+		var calls, completed atomic.Int32
+		res := check.EventuallyWith(t, func(t common.T) {
+			assert.Equal(t, int32(3), calls.Add(1))
+			completed.Add(1)
+		}, time.Second, time.Millisecond)
+		check.True(t, res)
+		check.Equal(t, int32(1), completed.Load())
+	})
+	t.Run("fails after the timeout", func(t *testing.T) {
+		t.Parallel()
+		mt := &common.MockT{}
+		res := check.EventuallyWith(mt, func(t common.T) {
+			assert.True(t, false)
+		}, 20*time.Millisecond, time.Millisecond)
+		check.False(t, res)
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	})
+}
+
+func TestConsistently(t *testing.T) {
+	t.Parallel()
+	t.Run("passes if the condition is always true", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		res := check.Consistently(t, func() bool {
+			calls.Add(1)
+			return true
+		}, 20*time.Millisecond, time.Millisecond)
+		check.True(t, res)
+		check.GreaterThan(t, calls.Load(), int32(1))
+	})
+	t.Run("fails as soon as the condition is false", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		mt := &common.MockT{}
+		res := check.Consistently(mt, func() bool {
+			return calls.Add(1) < 3
+		}, time.Second, time.Millisecond)
+		check.False(t, res)
+		check.Equal(t, int32(3), calls.Load())
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	})
+}
+
+func TestConsistentlyWith(t *testing.T) {
+	t.Parallel()
+	t.Run("passes if every attempt passes", func(t *testing.T) {
+		t.Parallel()
+		res := check.ConsistentlyWith(t, func(t common.T) {
+			check.Equal(t, 1, 1)
+		}, 20*time.Millisecond, time.Millisecond)
+		check.True(t, res)
+	})
+	t.Run("fails as soon as an attempt fails", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		mt := &common.MockT{}
+		res := check.ConsistentlyWith(mt, func(t common.T) {
+			check.LessThan(t, calls.Add(1), int32(3))
+		}, time.Second, time.Millisecond)
+		check.False(t, res)
+		check.Equal(t, int32(3), calls.Load())
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	})
+}
+
+func TestPollingInterval(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	check.False(t, check.Eventually(mt, func() bool { return true }, time.Second, 0))
+	check.False(t, check.EventuallyWith(mt, func(common.T) {}, time.Second, -time.Second))
+	check.False(t, check.Consistently(mt, func() bool { return true }, time.Second, 0))
+	check.False(t, check.ConsistentlyWith(mt, func(common.T) {}, time.Second, 0))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+	for _, m := range mt.Messages() {
+		check.HasPrefix(t, "expected a positive interval", m.Text)
+	}
+}
+
+func TestPollingBlockedAttempt(t *testing.T) {
+	t.Parallel()
+	block := make(chan struct{})
+	defer close(block)
+	start := time.Now()
+
+	mt := &common.MockT{}
+	check.False(t, check.Eventually(mt, func() bool {
+		<-block
+		return true
+	}, 20*time.Millisecond, time.Millisecond))
+	check.True(t, check.ConsistentlyWith(mt, func(common.T) {
+		<-block
+	}, 20*time.Millisecond, time.Millisecond))
+
+	check.LessThan(t, time.Since(start), time.Minute)
+	if check.Equal(t, 1, len(mt.Messages())) {
+		check.Contains(t, "the last attempt was still running when time ran out", mt.Messages()[0].Text)
+	}
+}
+
+// countingReporter is a report.Reporter that counts the failures it reports.
+type countingReporter struct {
+	failures atomic.Int32
+}
+
+func (r *countingReporter) Report(t common.T, _ report.Failure) {
+	r.failures.Add(1)
+	t.Fail()
+}
+func TestPollingShortTimeouts(t *testing.T) {
+	t.Parallel()
+	// An attempt that returns straight away must never be mistaken for one
+	// that was still running when time ran out.
+	for i := 0; i < 200; i++ {
+		mt := &common.MockT{}
+		check.False(t, check.Eventually(mt, func() bool { return false }, 5*time.Millisecond, time.Millisecond))
+		check.False(t, check.ConsistentlyWith(mt, func(t common.T) { t.Fail() }, 5*time.Millisecond, time.Millisecond))
+		for _, m := range mt.Messages() {
+			check.NotContains(t, "still running", m.Text)
+		}
+	}
+}
+
+//nolint:paralleltest // changes the default reporter for every test
+func TestPollingAttemptsAreNotReported(t *testing.T) {
+	r := &countingReporter{}
+	report.SetDefault(r)
+	defer report.SetDefault(nil)
+
+	var calls atomic.Int32
+	check.True(t, check.EventuallyWith(t, func(t common.T) {
+		check.Equal(t, int32(3), calls.Add(1))
+	}, time.Second, time.Millisecond))
+	check.Equal(t, int32(0), r.failures.Load())
+}
@@ -0,0 +1,308 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/report"
+)
+
+// Float is a type constraint for floating-point numbers, used by the
+// approximate comparison checks.
+type Float interface {
+	~float32 | ~float64
+}
+
+// InDelta passes and returns true if the absolute difference between want and
+// got is at most delta.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// NaN and infinities are handled explicitly: two NaNs are considered equal,
+// and an infinity is only equal to an infinity with the same sign.
+func InDelta[F Float](t common.T, want F, got F, delta F) bool {
+	t.Helper()
+	return checkFloat(t, want, got, deltaTolerance(delta))
+}
+
+// InDeltaSlice passes and returns true if want and got have the same length,
+// and InDelta would pass for every pair of elements at the same index.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes every
+// index that is out of tolerance.
+func InDeltaSlice[F Float](t common.T, want []F, got []F, delta F) bool {
+	t.Helper()
+	return checkFloatSlice(t, want, got, deltaTolerance(delta))
+}
+
+// InDeltaMap passes and returns true if want and got have the same keys, and
+// InDelta would pass for every pair of values with the same key.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes every
+// key that is missing or out of tolerance.
+func InDeltaMap[Key comparable, F Float](t common.T, want map[Key]F, got map[Key]F, delta F) bool {
+	t.Helper()
+	return checkFloatMap(t, want, got, deltaTolerance(delta))
+}
+
+// InEpsilon passes and returns true if the relative error between want and
+// got, |want - got| / |want|, is at most epsilon. If want is 0, got must also
+// be 0.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// NaN and infinities are handled explicitly: two NaNs are considered equal,
+// and an infinity is only equal to an infinity with the same sign.
+func InEpsilon[F Float](t common.T, want F, got F, epsilon F) bool {
+	t.Helper()
+	return checkFloat(t, want, got, epsilonTolerance(epsilon))
+}
+
+// InEpsilonSlice passes and returns true if want and got have the same
+// length, and InEpsilon would pass for every pair of elements at the same
+// index.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes every
+// index that is out of tolerance.
+func InEpsilonSlice[F Float](t common.T, want []F, got []F, epsilon F) bool {
+	t.Helper()
+	return checkFloatSlice(t, want, got, epsilonTolerance(epsilon))
+}
+
+// InEpsilonMap passes and returns true if want and got have the same keys,
+// and InEpsilon would pass for every pair of values with the same key.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes every
+// key that is missing or out of tolerance.
+func InEpsilonMap[Key comparable, F Float](t common.T, want map[Key]F, got map[Key]F, epsilon F) bool {
+	t.Helper()
+	return checkFloatMap(t, want, got, epsilonTolerance(epsilon))
+}
+
+// WithinULP passes and returns true if want and got are at most ulps units in
+// the last place apart; that is, if there are fewer than ulps representable
+// floating-point numbers between them. Positive and negative zero are equal.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// NaN and infinities are handled explicitly: two NaNs are considered equal,
+// and an infinity is only equal to an infinity with the same sign.
+func WithinULP[F Float](t common.T, want F, got F, ulps uint64) bool {
+	t.Helper()
+	return checkFloat(t, want, got, ulpTolerance[F](ulps))
+}
+
+// WithinULPSlice passes and returns true if want and got have the same
+// length, and WithinULP would pass for every pair of elements at the same
+// index.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes every
+// index that is out of tolerance.
+func WithinULPSlice[F Float](t common.T, want []F, got []F, ulps uint64) bool {
+	t.Helper()
+	return checkFloatSlice(t, want, got, ulpTolerance[F](ulps))
+}
+
+// WithinULPMap passes and returns true if want and got have the same keys,
+// and WithinULP would pass for every pair of values with the same key.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes every
+// key that is missing or out of tolerance.
+func WithinULPMap[Key comparable, F Float](t common.T, want map[Key]F, got map[Key]F, ulps uint64) bool {
+	t.Helper()
+	return checkFloatMap(t, want, got, ulpTolerance[F](ulps))
+}
+
+// tolerance describes how close two floats must be to pass a check.
+type tolerance[F Float] struct {
+	// description is used in failure messages, like "within delta 0.1".
+	description string
+	// within returns true if want and got are close enough, along with a
+	// description of how far apart they are, like "diff: 0.5".
+	within func(want, got F) (bool, string)
+}
+
+func deltaTolerance[F Float](delta F) tolerance[F] {
+	return tolerance[F]{
+		description: fmt.Sprintf("within delta %v", delta),
+		within: func(want, got F) (bool, string) {
+			if !(delta >= 0) {
+				return false, fmt.Sprintf("delta must be a non-negative number, received %v", delta)
+			}
+			diff := F(math.Abs(float64(want) - float64(got)))
+			detail := fmt.Sprintf("diff: %v", diff)
+			if ok, special := compareSpecial(want, got); special {
+				return ok, detail
+			}
+			return diff <= delta, detail
+		},
+	}
+}
+
+func epsilonTolerance[F Float](epsilon F) tolerance[F] {
+	return tolerance[F]{
+		description: fmt.Sprintf("within relative error %v", epsilon),
+		within: func(want, got F) (bool, string) {
+			if !(epsilon >= 0) {
+				return false, fmt.Sprintf("epsilon must be a non-negative number, received %v", epsilon)
+			}
+			if ok, special := compareSpecial(want, got); special {
+				return ok, "relative error: undefined"
+			}
+			if want == 0 {
+				return got == 0, "relative error: undefined because want is 0"
+			}
+			relative := math.Abs(float64(want)-float64(got)) / math.Abs(float64(want))
+			return relative <= float64(epsilon), fmt.Sprintf("relative error: %v", F(relative))
+		},
+	}
+}
+
+func ulpTolerance[F Float](ulps uint64) tolerance[F] {
+	return tolerance[F]{
+		description: fmt.Sprintf("within %d ulps", ulps),
+		within: func(want, got F) (bool, string) {
+			if ok, special := compareSpecial(want, got); special {
+				return ok, "ulps: undefined"
+			}
+			distance := ulpDistance(want, got)
+			return distance <= ulps, fmt.Sprintf("ulps: %d", distance)
+		},
+	}
+}
+
+// compareSpecial handles NaN and infinities, which can't be meaningfully
+// compared with a tolerance. If either value is special, it returns true as
+// its second result, along with whether or not the values are equal.
+func compareSpecial[F Float](want, got F) (bool, bool) {
+	w, g := float64(want), float64(got)
+	switch {
+	case math.IsNaN(w) || math.IsNaN(g):
+		return math.IsNaN(w) && math.IsNaN(g), true
+	case math.IsInf(w, 0) || math.IsInf(g, 0):
+		return w == g, true
+	default:
+		return false, false
+	}
+}
+
+// ulpDistance returns the number of representable floats between want and
+// got, using the precision of F.
+func ulpDistance[F Float](want, got F) uint64 {
+	if reflect.TypeOf(want).Kind() == reflect.Float32 {
+		a := orderedBits32(float32(want))
+		b := orderedBits32(float32(got))
+		if a > b {
+			a, b = b, a
+		}
+		return uint64(b - a)
+	}
+	a := orderedBits64(float64(want))
+	b := orderedBits64(float64(got))
+	if a > b {
+		a, b = b, a
+	}
+	return uint64(b) - uint64(a)
+}
+
+// orderedBits32 maps a float32 to an integer such that adjacent floats map to
+// adjacent integers, and positive and negative zero both map to 0.
+func orderedBits32(f float32) int64 {
+	bits := int64(int32(math.Float32bits(f)))
+	if bits < 0 {
+		bits = math.MinInt32 - bits
+	}
+	return bits
+}
+
+// orderedBits64 maps a float64 to an integer such that adjacent floats map to
+// adjacent integers, and positive and negative zero both map to 0.
+func orderedBits64(f float64) int64 {
+	bits := int64(math.Float64bits(f))
+	if bits < 0 {
+		bits = math.MinInt64 - bits
+	}
+	return bits
+}
+
+func checkFloat[F Float](t common.T, want, got F, tol tolerance[F]) bool {
+	t.Helper()
+	ok, detail := tol.within(want, got)
+	if ok {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected want and got to be %s\nwant: %v\n got: %v\n%s", tol.description, want, got, detail),
+		Want:    want,
+		Got:     got,
+	})
+	return false
+}
+
+func checkFloatSlice[F Float](t common.T, want, got []F, tol tolerance[F]) bool {
+	t.Helper()
+	if len(want) != len(got) {
+		report.Report(t, report.Failure{
+			Message: fmt.Sprintf("expected slices of equal length\nwant: len %d\n got: len %d", len(want), len(got)),
+			Want:    want,
+			Got:     got,
+		})
+		return false
+	}
+	var problems []string
+	for i := range want {
+		if ok, detail := tol.within(want[i], got[i]); !ok {
+			problems = append(problems, fmt.Sprintf("[%d]: want %v, got %v, %s", i, want[i], got[i], detail))
+		}
+	}
+	if len(problems) == 0 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected every element to be %s\n%s", tol.description, strings.Join(problems, "\n")),
+		Want:    want,
+		Got:     got,
+	})
+	return false
+}
+
+func checkFloatMap[Key comparable, F Float](t common.T, want, got map[Key]F, tol tolerance[F]) bool {
+	t.Helper()
+	var problems []string
+	for _, key := range sortedKeys(want) {
+		gotValue, ok := got[key]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("[%#v]: want %v, got <missing>", key, want[key]))
+			continue
+		}
+		if ok, detail := tol.within(want[key], gotValue); !ok {
+			problems = append(problems, fmt.Sprintf("[%#v]: want %v, got %v, %s", key, want[key], gotValue, detail))
+		}
+	}
+	for _, key := range sortedKeys(got) {
+		if _, ok := want[key]; !ok {
+			problems = append(problems, fmt.Sprintf("[%#v]: want <missing>, got %v", key, got[key]))
+		}
+	}
+	if len(problems) == 0 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected every value to be %s\n%s", tol.description, strings.Join(problems, "\n")),
+		Want:    want,
+		Got:     got,
+	})
+	return false
+}
@@ -0,0 +1,181 @@
+package check_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+type celsius float32
+
+func TestInDelta(t *testing.T) {
+	t.Parallel()
+	t.Run("finite", func(t *testing.T) {
+		t.Parallel()
+		check.InDelta(t, 0.3, 0.1+0.2, 1e-9)
+		check.InDelta(t, 1.0, 1.5, 0.5)
+		check.InDelta(t, celsius(20), celsius(20.25), 0.5)
+
+		mt := &common.MockT{}
+		check.False(t, check.InDelta(mt, 1.0, 1.6, 0.5))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	})
+	t.Run("NaN and infinities", func(t *testing.T) {
+		t.Parallel()
+		nan, inf := math.NaN(), math.Inf(1)
+		check.InDelta(t, nan, nan, 0.1)
+		check.InDelta(t, inf, inf, 0.1)
+		check.InDelta(t, -inf, -inf, 0.1)
+
+		for _, pair := range [][2]float64{
+			{nan, 1},
+			{1, nan},
+			{inf, -inf},
+			{inf, math.MaxFloat64},
+			{1, inf},
+		} {
+			mt := &common.MockT{}
+			check.False(t, check.InDelta(mt, pair[0], pair[1], math.MaxFloat64))
+			check.True(t, mt.Failed())
+		}
+	})
+	t.Run("invalid delta", func(t *testing.T) {
+		t.Parallel()
+		for _, delta := range []float64{-1, math.NaN()} {
+			mt := &common.MockT{}
+			check.False(t, check.InDelta(mt, 1.0, 1.0, delta))
+			check.True(t, mt.Failed())
+		}
+	})
+}
+
+func TestInDeltaSlice(t *testing.T) {
+	t.Parallel()
+	check.InDeltaSlice(t, []float64{1, 2, math.NaN()}, []float64{1.05, 1.95, math.NaN()}, 0.1)
+	check.InDeltaSlice(t, nil, []float64{}, 0.1)
+
+	mt := &common.MockT{}
+	check.False(t, check.InDeltaSlice(mt, []float64{1, 2, 3}, []float64{1, 2.5, 4}, 0.1))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+
+	mt = &common.MockT{}
+	check.False(t, check.InDeltaSlice(mt, []float64{1, 2, 3}, []float64{1, 2}, 0.1))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
+
+func TestInDeltaMap(t *testing.T) {
+	t.Parallel()
+	check.InDeltaMap(t, map[string]float64{"a": 1, "b": 2}, map[string]float64{"a": 1.05, "b": 1.95}, 0.1)
+
+	for _, got := range []map[string]float64{
+		{"a": 1, "b": 3},
+		{"a": 1},
+		{"a": 1, "b": 2, "c": 3},
+	} {
+		mt := &common.MockT{}
+		check.False(t, check.InDeltaMap(mt, map[string]float64{"a": 1, "b": 2}, got, 0.1))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	}
+}
+
+func TestInEpsilon(t *testing.T) {
+	t.Parallel()
+	check.InEpsilon(t, 100.0, 101.0, 0.01)
+	check.InEpsilon(t, -100.0, -99.0, 0.01)
+	check.InEpsilon(t, 0.0, 0.0, 0.01)
+	check.InEpsilon(t, math.NaN(), math.NaN(), 0.01)
+	check.InEpsilon(t, float32(1e-30), float32(1.001e-30), 0.01)
+
+	for _, pair := range [][2]float64{
+		{100, 102},
+		{0, 1e-300},
+		{1, math.NaN()},
+		{math.Inf(-1), 1},
+	} {
+		mt := &common.MockT{}
+		check.False(t, check.InEpsilon(mt, pair[0], pair[1], 0.01))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	}
+}
+
+func TestInEpsilonSlice(t *testing.T) {
+	t.Parallel()
+	check.InEpsilonSlice(t, []float64{100, 1000}, []float64{101, 1010}, 0.01)
+
+	mt := &common.MockT{}
+	check.False(t, check.InEpsilonSlice(mt, []float64{100, 1000}, []float64{102, 1020}, 0.01))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
+
+func TestInEpsilonMap(t *testing.T) {
+	t.Parallel()
+	check.InEpsilonMap(t, map[int]float64{1: 100}, map[int]float64{1: 101}, 0.01)
+
+	mt := &common.MockT{}
+	check.False(t, check.InEpsilonMap(mt, map[int]float64{1: 100}, map[int]float64{1: 102}, 0.01))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
+
+func TestWithinULP(t *testing.T) {
+	t.Parallel()
+	t.Run("float64", func(t *testing.T) {
+		t.Parallel()
+		check.WithinULP(t, 1.0, 1.0, 0)
+		check.WithinULP(t, 1.0, math.Nextafter(1, 2), 1)
+		check.WithinULP(t, 1.0, math.Nextafter(1, 0), 1)
+		check.WithinULP(t, 0.0, math.Copysign(0, -1), 0)
+		// The smallest positive and negative subnormals are 2 ulps apart.
+		check.WithinULP(t, -math.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64, 2)
+		check.WithinULP(t, math.Inf(1), math.Inf(1), 0)
+
+		mt := &common.MockT{}
+		check.False(t, check.WithinULP(mt, 1.0, math.Nextafter(math.Nextafter(1, 2), 2), 1))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+
+		mt = &common.MockT{}
+		check.False(t, check.WithinULP(mt, math.MaxFloat64, math.Inf(1), math.MaxUint64))
+		check.True(t, mt.Failed())
+	})
+	t.Run("float32", func(t *testing.T) {
+		t.Parallel()
+		one := float32(1)
+		next := math.Nextafter32(one, 2)
+		check.WithinULP(t, one, next, 1)
+		check.WithinULP(t, celsius(one), celsius(next), 1)
+
+		mt := &common.MockT{}
+		check.False(t, check.WithinULP(mt, one, math.Nextafter32(next, 2), 1))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	})
+}
+
+func TestWithinULPSlice(t *testing.T) {
+	t.Parallel()
+	check.WithinULPSlice(t, []float64{1, 2}, []float64{math.Nextafter(1, 2), 2}, 1)
+
+	mt := &common.MockT{}
+	check.False(t, check.WithinULPSlice(mt, []float64{1, 2}, []float64{1, 2.5}, 1))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
+
+func TestWithinULPMap(t *testing.T) {
+	t.Parallel()
+	check.WithinULPMap(t, map[string]float64{"a": 1}, map[string]float64{"a": math.Nextafter(1, 2)}, 1)
+
+	mt := &common.MockT{}
+	check.False(t, check.WithinULPMap(mt, map[string]float64{"a": 1}, map[string]float64{"a": 1.5}, 1))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
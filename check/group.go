@@ -0,0 +1,126 @@
+package check
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"sync"
+
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/report"
+)
+
+// Go calls fn on a new goroutine and returns a Group that waits for it. Use
+// Group.Go to start more goroutines in the same group, and call Group.Wait
+// from the test's goroutine before the test ends:
+//
+//	g := check.Go(t, func(t common.T) {
+//		assert.NoError(t, server.Serve()) // safe, even on another goroutine
+//	})
+//	g.Go(func(t common.T) { ... })
+//	g.Wait()
+//
+// fn receives its own common.T, which collects its failures instead of
+// reporting them, because calling t.FailNow() from any goroutine other than
+// the test's is not allowed. Inside fn, t.FailNow() stops only that goroutine.
+// A panic inside fn is recovered and stops only that goroutine, too.
+func Go(t common.T, fn func(t common.T)) *Group {
+	g := &Group{t: t}
+	g.Go(fn)
+	return g
+}
+
+// Group is a group of goroutines started by Go, whose failures are reported
+// to the test by Wait.
+type Group struct {
+	t          common.T
+	wg         sync.WaitGroup
+	mu         sync.Mutex
+	goroutines []*goroutine
+}
+
+// goroutine is the result of one function started by Group.Go.
+type goroutine struct {
+	t        *common.MockT
+	panicked string
+}
+
+// Go calls fn on a new goroutine in the group.
+func (g *Group) Go(fn func(t common.T)) {
+	gr := &goroutine{t: &common.MockT{}}
+	g.mu.Lock()
+	g.goroutines = append(g.goroutines, gr)
+	g.mu.Unlock()
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		// The failures are reported again by Wait, with the test's reporter.
+		report.Use(gr.t, report.Plain{})
+		gr.t.Run(func(t *common.MockT) {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					gr.panicked = fmt.Sprintf("%v\n%s", recovered, debug.Stack())
+				}
+			}()
+			fn(t)
+		})
+	}()
+}
+
+// Wait waits for every goroutine in the group to finish, and passes and
+// returns true if none of them failed.
+//
+// Otherwise, each goroutine that failed is reported separately, with the
+// failure messages it collected. If any of them called t.FailNow() or
+// panicked, the test is then immediately failed and stopped with t.FailNow().
+// Otherwise, the test is marked as failed with t.Error(), this function
+// returns false, and the test continues running.
+func (g *Group) Wait() bool {
+	g.t.Helper()
+	g.wg.Wait()
+	g.mu.Lock()
+	goroutines := g.goroutines
+	g.goroutines = nil
+	g.mu.Unlock()
+	ok, fatal := true, false
+	for i, gr := range goroutines {
+		failedNow := gr.t.FailedNow() || gr.panicked != ""
+		if !gr.t.Failed() && !failedNow {
+			continue
+		}
+		ok = false
+		fatal = fatal || failedNow
+		report.Report(g.t, report.Failure{
+			Message: fmt.Sprintf("goroutine %d of %d %s", i+1, len(goroutines), gr.describe()),
+			Fatal:   failedNow,
+		})
+	}
+	if fatal {
+		g.t.FailNow()
+	}
+	return ok
+}
+
+// describe explains how the goroutine failed, with the messages it collected
+// and where they came from, indented.
+func (gr *goroutine) describe() string {
+	var lines []string
+	for _, m := range gr.t.Messages() {
+		if m.Error {
+			lines = append(lines, fmt.Sprintf("%s:%d: %s", filepath.Base(m.File), m.Line, m.Text))
+		}
+	}
+	summary := "failed:"
+	switch {
+	case gr.panicked != "":
+		summary = "panicked:"
+		lines = append(lines, gr.panicked)
+	case len(lines) == 0:
+		return "failed without a message"
+	}
+	for i, line := range lines {
+		lines[i] = "    " + strings.ReplaceAll(strings.TrimSuffix(line, "\n"), "\n", "\n    ")
+	}
+	return summary + "\n" + strings.Join(lines, "\n")
+}
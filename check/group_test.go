@@ -0,0 +1,67 @@
+package check_test
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+func TestGo(t *testing.T) {
+	t.Parallel()
+	results := make([]int, 3)
+	g := check.Go(t, func(t common.T) {
+		results[0] = 1
+	})
+	for i := 1; i < len(results); i++ {
+		i := i
+		g.Go(func(t common.T) {
+			check.True(t, i > 0)
+			results[i] = i + 1
+		})
+	}
+	check.True(t, g.Wait())
+	check.Equal(t, []int{1, 2, 3}, results)
+
+	mt := &common.MockT{}
+	_, _, line, _ := runtime.Caller(0)
+	g = check.Go(mt, func(t common.T) {
+		check.True(t, false)
+		check.Equal(t, 1, 1)
+		check.False(t, true)
+	})
+	g.Go(func(t common.T) {})
+	check.False(t, g.Wait())
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+	check.Equal(t, []string{
+		"goroutine 1 of 2 failed:\n" +
+			"    group_test.go:" + strconv.Itoa(line+2) + ": expected true\n" +
+			"    group_test.go:" + strconv.Itoa(line+4) + ": expected false",
+	}, mt.Errors())
+}
+
+func TestGoFailNow(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	reached := false
+	g := check.Go(mt, func(t common.T) {
+		t.FailNow()
+		reached = true
+	})
+	g.Go(func(t common.T) {
+		panic("boom")
+	})
+	check.False(t, g.Wait())
+	check.False(t, reached)
+	check.True(t, mt.FailedNow())
+	if check.Equal(t, 2, len(mt.Errors())) {
+		check.Equal(t, "goroutine 1 of 2 failed without a message", mt.Errors()[0])
+		check.HasPrefix(t, "goroutine 2 of 2 panicked:\n    boom\n    goroutine ", mt.Errors()[1])
+		check.Contains(t, "group_test.go", mt.Errors()[1])
+		check.False(t, strings.HasSuffix(mt.Errors()[1], "\n"))
+	}
+}
@@ -0,0 +1,290 @@
+package check
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/internal/preview"
+	"github.com/peterldowns/testy/report"
+)
+
+// JSONOption changes the behavior of JSONEqual.
+type JSONOption func(*jsonConfig)
+
+// JSONExactNumbers compares JSON numbers as exact decimals, so that numbers
+// which are too large or precise to be represented as a float64 are compared
+// correctly. By default, numbers are compared as float64 values.
+func JSONExactNumbers() JSONOption {
+	return func(c *jsonConfig) {
+		c.exactNumbers = true
+	}
+}
+
+// JSONIgnorePaths ignores the values at the given paths, like
+// "$.meta.requestId". Array elements can be matched with an index like
+// "$.items[0].id", and "*" matches any single object key or array index, like
+// "$.items[*].id".
+func JSONIgnorePaths(paths ...string) JSONOption {
+	return func(c *jsonConfig) {
+		for _, path := range paths {
+			c.ignorePaths = append(c.ignorePaths, parseJSONPath(path))
+		}
+	}
+}
+
+// JSONAllowExtraFields allows objects in got to contain fields that are not
+// present in the corresponding objects in want.
+func JSONAllowExtraFields() JSONOption {
+	return func(c *jsonConfig) {
+		c.allowExtraFields = true
+	}
+}
+
+// JSONEqual passes and returns true if want and got are equivalent JSON
+// documents.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// Both documents are parsed and compared structurally using go-cmp, so the
+// order of object keys and whitespace do not matter. On failure, every
+// difference is reported with the path to the differing value, like
+// "$.items[1].name". You can change the behavior of the comparison using
+// JSONExactNumbers, JSONIgnorePaths, and JSONAllowExtraFields.
+func JSONEqual(t common.T, want []byte, got []byte, opts ...JSONOption) bool {
+	t.Helper()
+	config := jsonConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	wantValue, err := parseJSON(want)
+	if err != nil {
+		report.Report(t, report.Failure{
+			Message: fmt.Sprintf("expected want to be valid JSON\nerr: %s\nwant: %s", err, preview.Text(string(want))),
+			Want:    string(want),
+			Got:     string(got),
+		})
+		return false
+	}
+	gotValue, err := parseJSON(got)
+	if err != nil {
+		report.Report(t, report.Failure{
+			Message: fmt.Sprintf("expected got to be valid JSON\nerr: %s\ngot: %s", err, preview.Text(string(got))),
+			Want:    string(want),
+			Got:     string(got),
+		})
+		return false
+	}
+	reporter := &jsonReporter{}
+	if gocmp.Equal(wantValue, gotValue, append(config.cmpOptions(), gocmp.Reporter(reporter))...) {
+		return true
+	}
+	diffs := strings.Join(reporter.diffs, "\n")
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected JSON want == got\n%s", diffs),
+		Want:    string(want),
+		Got:     string(got),
+		Diff:    diffs,
+	})
+	return false
+}
+
+// jsonConfig is built from the JSONOptions passed to JSONEqual.
+type jsonConfig struct {
+	exactNumbers     bool
+	ignorePaths      [][]string
+	allowExtraFields bool
+}
+
+// cmpOptions returns the go-cmp options that implement the configuration.
+func (c jsonConfig) cmpOptions() []gocmp.Option {
+	opts := []gocmp.Option{gocmp.Comparer(equalFloatNumbers)}
+	if c.exactNumbers {
+		opts[0] = gocmp.Comparer(equalExactNumbers)
+	}
+	if len(c.ignorePaths) != 0 {
+		opts = append(opts, gocmp.FilterPath(func(p gocmp.Path) bool {
+			path := jsonPathSegments(p)
+			for _, pattern := range c.ignorePaths {
+				if matchJSONPath(pattern, path) {
+					return true
+				}
+			}
+			return false
+		}, gocmp.Ignore()))
+	}
+	if c.allowExtraFields {
+		opts = append(opts, gocmp.FilterPath(func(p gocmp.Path) bool {
+			step, ok := p.Last().(gocmp.MapIndex)
+			if !ok {
+				return false
+			}
+			want, _ := step.Values()
+			return !want.IsValid()
+		}, gocmp.Ignore()))
+	}
+	return opts
+}
+
+// parseJSON decodes a single JSON document, preserving numbers as
+// json.Number so that they can be compared exactly.
+func parseJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after the JSON document")
+	}
+	return value, nil
+}
+
+func equalFloatNumbers(x, y json.Number) bool {
+	fx, errx := x.Float64()
+	fy, erry := y.Float64()
+	if errx != nil || erry != nil {
+		return x == y
+	}
+	return fx == fy
+}
+
+func equalExactNumbers(x, y json.Number) bool {
+	rx, okx := new(big.Rat).SetString(string(x))
+	ry, oky := new(big.Rat).SetString(string(y))
+	if !okx || !oky {
+		return x == y
+	}
+	return rx.Cmp(ry) == 0
+}
+
+// jsonPathSegments returns the object keys and array indexes of a go-cmp
+// path, like ["items", "[1]", "name"].
+func jsonPathSegments(p gocmp.Path) []string {
+	segments := []string{}
+	for _, step := range p {
+		switch s := step.(type) {
+		case gocmp.MapIndex:
+			segments = append(segments, s.Key().String())
+		case gocmp.SliceIndex:
+			want, got := s.SplitKeys()
+			index := got
+			if index < 0 {
+				index = want
+			}
+			segments = append(segments, "["+strconv.Itoa(index)+"]")
+		}
+	}
+	return segments
+}
+
+// formatJSONPath formats path segments like "$.items[1].name".
+func formatJSONPath(segments []string) string {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, segment := range segments {
+		if !strings.HasPrefix(segment, "[") {
+			b.WriteString(".")
+		}
+		b.WriteString(segment)
+	}
+	return b.String()
+}
+
+// parseJSONPath splits a path like "$.items[*].name" into segments like
+// ["items", "[*]", "name"].
+func parseJSONPath(path string) []string {
+	path = strings.TrimPrefix(path, "$")
+	segments := []string{}
+	for _, part := range strings.Split(path, ".") {
+		for part != "" {
+			i := strings.Index(part, "[")
+			switch {
+			case i < 0:
+				segments = append(segments, part)
+				part = ""
+			case i > 0:
+				segments = append(segments, part[:i])
+				part = part[i:]
+			default:
+				end := strings.Index(part, "]")
+				if end < 0 {
+					segments = append(segments, part)
+					part = ""
+					continue
+				}
+				segments = append(segments, part[:end+1])
+				part = part[end+1:]
+			}
+		}
+	}
+	return segments
+}
+
+// matchJSONPath returns true if path matches pattern, where "*" and "[*]"
+// match any single key or index.
+func matchJSONPath(pattern, path []string) bool {
+	if len(pattern) != len(path) {
+		return false
+	}
+	for i, segment := range pattern {
+		isIndex := strings.HasPrefix(path[i], "[")
+		switch {
+		case segment == "*" && !isIndex:
+		case segment == "[*]" && isIndex:
+		case segment == path[i]:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// jsonReporter is a go-cmp Reporter that records each difference between two
+// JSON documents along with its path.
+type jsonReporter struct {
+	path  gocmp.Path
+	diffs []string
+}
+
+func (r *jsonReporter) PushStep(step gocmp.PathStep) {
+	r.path = append(r.path, step)
+}
+
+func (r *jsonReporter) PopStep() {
+	r.path = r.path[:len(r.path)-1]
+}
+
+func (r *jsonReporter) Report(result gocmp.Result) {
+	if result.Equal() {
+		return
+	}
+	want, got := r.path.Last().Values()
+	r.diffs = append(r.diffs, fmt.Sprintf(
+		"%s: want %s, got %s",
+		formatJSONPath(jsonPathSegments(r.path)),
+		formatJSONValue(want),
+		formatJSONValue(got),
+	))
+}
+
+// formatJSONValue formats a parsed JSON value as compact JSON.
+func formatJSONValue(value reflect.Value) string {
+	if !value.IsValid() {
+		return "<missing>"
+	}
+	data, err := json.Marshal(value.Interface())
+	if err != nil {
+		return preview.Value(value.Interface())
+	}
+	return preview.Text(string(data))
+}
@@ -0,0 +1,101 @@
+package check_test
+
+import (
+	"testing"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+func TestJSONEqual(t *testing.T) {
+	t.Parallel()
+	t.Run("key order and whitespace", func(t *testing.T) {
+		t.Parallel()
+		check.JSONEqual(t,
+			[]byte(`{"name": "peter", "tags": ["a", "b"], "meta": {"count": 1}}`),
+			[]byte(`{
+				"meta": {"count": 1.0},
+				"tags": ["a", "b"],
+				"name": "peter"
+			}`),
+		)
+
+		mt := &common.MockT{}
+		check.JSONEqual(mt, []byte(`{"tags": ["a", "b"]}`), []byte(`{"tags": ["b", "a"]}`))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	})
+	t.Run("different values", func(t *testing.T) {
+		t.Parallel()
+		for _, got := range []string{
+			`{"name": "bob"}`,
+			`{"name": null}`,
+			`{"name": 1}`,
+			`{}`,
+			`{"name": "peter", "age": 29}`,
+			`["peter"]`,
+		} {
+			mt := &common.MockT{}
+			check.False(t, check.JSONEqual(mt, []byte(`{"name": "peter"}`), []byte(got)))
+			check.True(t, mt.Failed())
+			check.False(t, mt.FailedNow())
+		}
+	})
+	t.Run("invalid JSON", func(t *testing.T) {
+		t.Parallel()
+		for _, pair := range [][2]string{
+			{`{"name": "peter"`, `{}`},
+			{`{}`, `{"name": }`},
+			{`{}`, `{} {}`},
+			{``, `{}`},
+		} {
+			mt := &common.MockT{}
+			check.False(t, check.JSONEqual(mt, []byte(pair[0]), []byte(pair[1])))
+			check.True(t, mt.Failed())
+			check.False(t, mt.FailedNow())
+		}
+	})
+	t.Run("exact numbers", func(t *testing.T) {
+		t.Parallel()
+		// These are different numbers, but they are equal as float64s.
+		want := []byte(`{"id": 12345678901234567890123}`)
+		got := []byte(`{"id": 12345678901234567890124}`)
+		check.JSONEqual(t, want, got)
+		check.JSONEqual(t, []byte(`1.50`), []byte(`1.5`), check.JSONExactNumbers())
+
+		mt := &common.MockT{}
+		check.JSONEqual(mt, want, got, check.JSONExactNumbers())
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	})
+	t.Run("ignore paths", func(t *testing.T) {
+		t.Parallel()
+		want := []byte(`{"meta": {"requestId": "abc"}, "items": [{"id": 1, "name": "a"}, {"id": 2, "name": "b"}]}`)
+		got := []byte(`{"meta": {"requestId": "xyz"}, "items": [{"id": 3, "name": "a"}, {"id": 4, "name": "b"}]}`)
+		check.JSONEqual(t, want, got, check.JSONIgnorePaths("$.meta.requestId", "$.items[*].id"))
+		check.JSONEqual(t, want, got, check.JSONIgnorePaths("$.meta.*", "$.items[0].id", "$.items[1].id"))
+
+		mt := &common.MockT{}
+		check.JSONEqual(mt, want, got, check.JSONIgnorePaths("$.meta.requestId", "$.items[0].id"))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	})
+	t.Run("allow extra fields", func(t *testing.T) {
+		t.Parallel()
+		want := []byte(`{"name": "peter", "pets": [{"name": "fido"}]}`)
+		got := []byte(`{"name": "peter", "age": 29, "pets": [{"name": "fido", "species": "dog"}]}`)
+		check.JSONEqual(t, want, got, check.JSONAllowExtraFields())
+
+		// Missing fields are still reported.
+		mt := &common.MockT{}
+		check.JSONEqual(mt, got, want, check.JSONAllowExtraFields())
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+
+		// Extra array elements are still reported.
+		mt = &common.MockT{}
+		check.JSONEqual(mt, []byte(`[1]`), []byte(`[1, 2]`), check.JSONAllowExtraFields())
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	})
+}
@@ -0,0 +1,170 @@
+package check
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/report"
+)
+
+// Comparer is implemented by types with a Compare method that returns a
+// negative number if the receiver is less than other, zero if they are equal,
+// and a positive number if the receiver is greater than other. For example,
+// time.Time and netip.Addr are Comparers.
+type Comparer[Type any] interface {
+	Compare(other Type) int
+}
+
+// Before passes and returns true if small.Compare(big) < 0.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+func Before[Type Comparer[Type]](t common.T, small Type, big Type) bool {
+	t.Helper()
+	if small.Compare(big) < 0 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected %s to be before %s", formatValue(small), formatValue(big)),
+		Want:    big,
+		Got:     small,
+	})
+	return false
+}
+
+// After passes and returns true if big.Compare(small) > 0.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+func After[Type Comparer[Type]](t common.T, big Type, small Type) bool {
+	t.Helper()
+	if big.Compare(small) > 0 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected %s to be after %s", formatValue(big), formatValue(small)),
+		Want:    small,
+		Got:     big,
+	})
+	return false
+}
+
+// InRangeCompare passes and returns true if lo.Compare(got) <= 0 and
+// got.Compare(hi) <= 0.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+func InRangeCompare[Type Comparer[Type]](t common.T, lo Type, hi Type, got Type) bool {
+	t.Helper()
+	return InRangeFunc(t, lo, hi, got, Type.Compare)
+}
+
+// InRange passes and returns true if lo <= got <= hi.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+func InRange[Type cmp.Ordered](t common.T, lo Type, hi Type, got Type) bool {
+	t.Helper()
+	return InRangeFunc(t, lo, hi, got, cmp.Compare[Type])
+}
+
+// InRangeFunc passes and returns true if compare(lo, got) <= 0 and
+// compare(got, hi) <= 0.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// compare must return a negative number if a < b, zero if a == b, and a
+// positive number if a > b. Method expressions like (*big.Int).Cmp work well.
+func InRangeFunc[Type any](t common.T, lo Type, hi Type, got Type, compare func(a, b Type) int) bool {
+	t.Helper()
+	if compare(lo, got) <= 0 && compare(got, hi) <= 0 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected %s <= got <= %s\ngot: %s", formatValue(lo), formatValue(hi), formatValue(got)),
+		Got:     got,
+	})
+	return false
+}
+
+// LessThanFunc passes and returns true if compare(small, big) < 0.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// compare must return a negative number if a < b, zero if a == b, and a
+// positive number if a > b. Method expressions like (*big.Int).Cmp work well.
+func LessThanFunc[Type any](t common.T, small Type, big Type, compare func(a, b Type) int) bool {
+	t.Helper()
+	if compare(small, big) < 0 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected %s < %s", formatValue(small), formatValue(big)),
+		Want:    big,
+		Got:     small,
+	})
+	return false
+}
+
+// LessThanOrEqualFunc passes and returns true if compare(small, big) <= 0.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// compare must return a negative number if a < b, zero if a == b, and a
+// positive number if a > b. Method expressions like (*big.Int).Cmp work well.
+func LessThanOrEqualFunc[Type any](t common.T, small Type, big Type, compare func(a, b Type) int) bool {
+	t.Helper()
+	if compare(small, big) <= 0 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected %s <= %s", formatValue(small), formatValue(big)),
+		Want:    big,
+		Got:     small,
+	})
+	return false
+}
+
+// GreaterThanFunc passes and returns true if compare(big, small) > 0.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// compare must return a negative number if a < b, zero if a == b, and a
+// positive number if a > b. Method expressions like (*big.Int).Cmp work well.
+func GreaterThanFunc[Type any](t common.T, big Type, small Type, compare func(a, b Type) int) bool {
+	t.Helper()
+	if compare(big, small) > 0 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected %s > %s", formatValue(big), formatValue(small)),
+		Want:    small,
+		Got:     big,
+	})
+	return false
+}
+
+// GreaterThanOrEqualFunc passes and returns true if compare(big, small) >= 0.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// compare must return a negative number if a < b, zero if a == b, and a
+// positive number if a > b. Method expressions like (*big.Int).Cmp work well.
+func GreaterThanOrEqualFunc[Type any](t common.T, big Type, small Type, compare func(a, b Type) int) bool {
+	t.Helper()
+	if compare(big, small) >= 0 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected %s >= %s", formatValue(big), formatValue(small)),
+		Want:    small,
+		Got:     big,
+	})
+	return false
+}
@@ -0,0 +1,129 @@
+package check_test
+
+import (
+	"cmp"
+	"math/big"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+// cents is a decimal type with a Compare method and a String method.
+type cents int64
+
+func (c cents) Compare(other cents) int {
+	return cmp.Compare(c, other)
+}
+
+func (c cents) String() string {
+	return big.NewRat(int64(c), 100).FloatString(2)
+}
+
+func TestBefore(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	check.Before(t, now, now.Add(time.Second))
+	check.Before(t, netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("10.0.0.2"))
+	check.Before(t, cents(199), cents(200))
+
+	for _, small := range []time.Time{now, now.Add(time.Second)} {
+		mt := &common.MockT{}
+		check.False(t, check.Before(mt, small, now))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	}
+}
+
+func TestAfter(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	check.After(t, now.Add(time.Second), now)
+	check.After(t, cents(200), cents(199))
+
+	for _, big := range []cents{199, 200} {
+		mt := &common.MockT{}
+		check.False(t, check.After(mt, big, cents(200)))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	}
+}
+
+func TestInRangeCompare(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	check.InRangeCompare(t, now, now.Add(time.Hour), now)
+	check.InRangeCompare(t, now, now.Add(time.Hour), now.Add(time.Minute))
+	check.InRangeCompare(t, now, now.Add(time.Hour), now.Add(time.Hour))
+
+	for _, got := range []time.Time{now.Add(-time.Minute), now.Add(2 * time.Hour)} {
+		mt := &common.MockT{}
+		check.False(t, check.InRangeCompare(mt, now, now.Add(time.Hour), got))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	}
+}
+
+func TestInRange(t *testing.T) {
+	t.Parallel()
+	check.InRange(t, 1, 10, 1)
+	check.InRange(t, 1, 10, 5)
+	check.InRange(t, 1, 10, 10)
+	check.InRange(t, "a", "c", "b")
+
+	for _, got := range []float64{0.9, 10.1} {
+		mt := &common.MockT{}
+		check.False(t, check.InRange(mt, 1.0, 10.0, got))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	}
+}
+
+func TestInRangeFunc(t *testing.T) {
+	t.Parallel()
+	lo, hi := big.NewInt(1), big.NewInt(100)
+	check.InRangeFunc(t, lo, hi, big.NewInt(50), (*big.Int).Cmp)
+
+	mt := &common.MockT{}
+	check.False(t, check.InRangeFunc(mt, lo, hi, big.NewInt(101), (*big.Int).Cmp))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
+
+func TestLessThanFunc(t *testing.T) {
+	t.Parallel()
+	check.LessThanFunc(t, big.NewInt(1), big.NewInt(2), (*big.Int).Cmp)
+	check.LessThanOrEqualFunc(t, big.NewInt(1), big.NewInt(2), (*big.Int).Cmp)
+	check.LessThanOrEqualFunc(t, big.NewInt(2), big.NewInt(2), (*big.Int).Cmp)
+	check.LessThanFunc(t, cents(1), cents(2), cents.Compare)
+
+	mt := &common.MockT{}
+	check.False(t, check.LessThanFunc(mt, big.NewInt(2), big.NewInt(2), (*big.Int).Cmp))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+
+	mt = &common.MockT{}
+	check.False(t, check.LessThanOrEqualFunc(mt, big.NewInt(3), big.NewInt(2), (*big.Int).Cmp))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
+
+func TestGreaterThanFunc(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	check.GreaterThanFunc(t, now.Add(time.Second), now, time.Time.Compare)
+	check.GreaterThanOrEqualFunc(t, now.Add(time.Second), now, time.Time.Compare)
+	check.GreaterThanOrEqualFunc(t, now, now.UTC(), time.Time.Compare)
+
+	mt := &common.MockT{}
+	check.False(t, check.GreaterThanFunc(mt, now, now.UTC(), time.Time.Compare))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+
+	mt = &common.MockT{}
+	check.False(t, check.GreaterThanOrEqualFunc(mt, now, now.Add(time.Second), time.Time.Compare))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
@@ -0,0 +1,125 @@
+package check
+
+import (
+	"fmt"
+	"runtime/debug"
+	"strings"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/internal/diff"
+	"github.com/peterldowns/testy/internal/preview"
+	"github.com/peterldowns/testy/report"
+)
+
+// Panics passes and returns true if fn panics when called.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+func Panics(t common.T, fn func()) bool {
+	t.Helper()
+	if p := callAndRecover(fn); p != nil {
+		return true
+	}
+	report.Report(t, report.Failure{Message: "expected function to panic"})
+	return false
+}
+
+// NotPanics passes and returns true if fn does not panic when called.
+//
+// Otherwise, the panic is recovered, the test is marked as failed with
+// t.Error(), this function returns false, and the test continues running. The
+// failure message includes the recovered value and the stack of the panicking
+// goroutine.
+func NotPanics(t common.T, fn func()) bool {
+	t.Helper()
+	p := callAndRecover(fn)
+	if p == nil {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected function to not panic\nrecovered: %s\n    stack:\n%s", preview.Value(p.value), p.indentedStack()),
+		Got:     p.value,
+	})
+	return false
+}
+
+// PanicsWith passes and returns true if fn panics when called, and the
+// recovered value is of the same type as want and equal to it.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// This is a typesafe check for equality using go-cmp, just like Equal.
+//
+// You can change the behavior of the equality checking using the go-cmp/cmp
+// Options system. For more information, see [the go-cmp documentation](https://pkg.go.dev/github.com/google/go-cmp/cmp#Equal).
+//
+// If want is a string or byte slice, the failure message shows a text diff of
+// the recovered value, like Equal does.
+func PanicsWith[Type any](t common.T, want Type, fn func(), opts ...gocmp.Option) bool {
+	t.Helper()
+	p := callAndRecover(fn)
+	if p == nil {
+		report.Report(t, report.Failure{
+			Message: fmt.Sprintf("expected function to panic\nwant: %s", preview.Value(want)),
+			Want:    want,
+		})
+		return false
+	}
+	got, ok := p.value.(Type)
+	if !ok {
+		report.Report(t, report.Failure{
+			Message: fmt.Sprintf("expected function to panic with a value of type %s\nrecovered: %s\n    stack:\n%s", typeName[Type](), preview.Value(p.value), p.indentedStack()),
+			Want:    want,
+			Got:     p.value,
+		})
+		return false
+	}
+	d := gocmp.Diff(want, got, opts...)
+	if d == "" {
+		return true
+	}
+	message := fmt.Sprintf("expected recovered value == want\n--- want\n+++ recovered\n%#v", d)
+	if wantText, gotText, ok := diff.AsText(want, got); ok && wantText != gotText {
+		d = diff.Text(wantText, gotText)
+		message = fmt.Sprintf("expected recovered value == want\n%s", d)
+	}
+	report.Report(t, report.Failure{
+		Message: message,
+		Want:    want,
+		Got:     got,
+		Diff:    d,
+		Options: opts,
+	})
+	return false
+}
+
+// recovered describes a panic.
+type recovered struct {
+	value any
+	stack []byte
+}
+
+// indentedStack returns the stack of the panicking goroutine, indented so
+// that it lines up within a failure message.
+func (r *recovered) indentedStack() string {
+	stack := strings.TrimSuffix(string(r.stack), "\n")
+	return "    " + strings.ReplaceAll(stack, "\n", "\n    ")
+}
+
+// callAndRecover calls fn and returns a description of its panic, or nil if it
+// returned normally. This detects panics even if the recovered value is nil.
+func callAndRecover(fn func()) (p *recovered) {
+	p = &recovered{}
+	defer func() {
+		if p != nil {
+			p.value = recover()
+			p.stack = debug.Stack()
+		}
+	}()
+	fn()
+	p = nil
+	return p
+}
@@ -0,0 +1,94 @@
+package check_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+func TestPanics(t *testing.T) {
+	t.Parallel()
+	check.Panics(t, func() { panic("oh no") })
+	check.Panics(t, func() {
+		var m map[string]int
+		m["hello"] = 1
+	})
+
+	mt := &common.MockT{}
+	res := check.Panics(mt, func() {})
+	check.False(t, res)
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
+
+func TestNotPanics(t *testing.T) {
+	t.Parallel()
+	check.NotPanics(t, func() {})
+
+	mt := &common.MockT{}
+	called := false
+	res := check.NotPanics(mt, func() {
+		called = true
+		panic("oh no")
+	})
+	check.True(t, called)
+	check.False(t, res)
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
+
+func TestPanicsWith(t *testing.T) {
+	t.Parallel()
+	t.Run("equal values", func(t *testing.T) {
+		t.Parallel()
+		check.PanicsWith(t, "oh no", func() { panic("oh no") })
+		check.PanicsWith(t, person{Name: "peter"}, func() { panic(person{Name: "peter"}) })
+	})
+	t.Run("different values", func(t *testing.T) {
+		t.Parallel()
+		mt := &common.MockT{}
+		res := check.PanicsWith(mt, "oh no", func() { panic("uh oh") })
+		check.False(t, res)
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+		if messages := mt.Messages(); check.Equal(t, 1, len(messages)) {
+			check.Equal(t, strings.Join([]string{
+				"expected recovered value == want",
+				`want: "oh no"`,
+				` got: "uh oh"`,
+				`       ^`,
+			}, "\n"), messages[0].Text)
+		}
+	})
+	t.Run("different types", func(t *testing.T) {
+		t.Parallel()
+		mt := &common.MockT{}
+		res := check.PanicsWith(mt, "oh no", func() { panic(errors.New("oh no")) })
+		check.False(t, res)
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	})
+	t.Run("no panic", func(t *testing.T) {
+		t.Parallel()
+		mt := &common.MockT{}
+		res := check.PanicsWith(mt, "oh no", func() {})
+		check.False(t, res)
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	})
+	t.Run("with cmp.opts", func(t *testing.T) {
+		t.Parallel()
+		check.PanicsWith[error](t, errNotFound, func() {
+			panic(fmt.Errorf("loading user: %w", errNotFound))
+		}, cmpopts.EquateErrors())
+		check.PanicsWith(t, hiddenPerson{Name: "peter"}, func() {
+			panic(hiddenPerson{Name: "peter", hidden: true})
+		}, cmpopts.IgnoreUnexported(hiddenPerson{}))
+	})
+}
@@ -0,0 +1,23 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/peterldowns/testy/internal/preview"
+)
+
+// typeName returns the name of the type parameter Type, which works even when
+// Type is an interface type.
+func typeName[Type any]() string {
+	return reflect.TypeOf((*Type)(nil)).Elem().String()
+}
+
+// formatValue formats val with its String method, if it has one, and
+// otherwise with preview.Value.
+func formatValue(val any) string {
+	if s, ok := val.(fmt.Stringer); ok && !isNil(val) {
+		return preview.Text(s.String())
+	}
+	return preview.Value(val)
+}
@@ -0,0 +1,243 @@
+package check
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/internal/preview"
+	"github.com/peterldowns/testy/report"
+)
+
+// Text is a type constraint for strings and byte slices, used by the string
+// checks.
+type Text interface {
+	~string | ~[]byte
+}
+
+// matchContext is the number of bytes of context shown on either side of a
+// match when a long string is shown in a failure message.
+const matchContext = 40
+
+// Contains passes and returns true if s contains substring.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message shows s with the
+// closest match to substring marked.
+func Contains[Sub Text, S Text](t common.T, substring Sub, s S) bool {
+	t.Helper()
+	needle, haystack := string(substring), string(s)
+	if strings.Contains(haystack, needle) {
+		return true
+	}
+	start, length := closestMatch(needle, haystack)
+	if length == 0 {
+		length = -1 // nothing in common, so there is nothing to mark
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected s to contain substring\nsubstring: %s\n%s", quote(needle), markMatch("        s: ", haystack, start, length)),
+		Want:    needle,
+		Got:     haystack,
+	})
+	return false
+}
+
+// NotContains passes and returns true if s does not contain substring.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message shows s with the
+// first occurrence of substring marked.
+func NotContains[Sub Text, S Text](t common.T, substring Sub, s S) bool {
+	t.Helper()
+	needle, haystack := string(substring), string(s)
+	start := strings.Index(haystack, needle)
+	if start == -1 {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected s to not contain substring\nsubstring: %s\n%s", quote(needle), markMatch("        s: ", haystack, start, len(needle))),
+		Want:    needle,
+		Got:     haystack,
+	})
+	return false
+}
+
+// HasPrefix passes and returns true if s begins with prefix.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message shows the
+// beginning of s with the part that matches prefix marked.
+func HasPrefix[Prefix Text, S Text](t common.T, prefix Prefix, s S) bool {
+	t.Helper()
+	needle, haystack := string(prefix), string(s)
+	if strings.HasPrefix(haystack, needle) {
+		return true
+	}
+	length := commonPrefixLength(needle, haystack)
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected s to have prefix\nprefix: %s\n%s", quote(needle), markMatch("     s: ", haystack, 0, length)),
+		Want:    needle,
+		Got:     haystack,
+	})
+	return false
+}
+
+// HasSuffix passes and returns true if s ends with suffix.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message shows the end of
+// s with the part that matches suffix marked.
+func HasSuffix[Suffix Text, S Text](t common.T, suffix Suffix, s S) bool {
+	t.Helper()
+	needle, haystack := string(suffix), string(s)
+	if strings.HasSuffix(haystack, needle) {
+		return true
+	}
+	length := commonSuffixLength(needle, haystack)
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected s to have suffix\nsuffix: %s\n%s", quote(needle), markMatch("     s: ", haystack, len(haystack)-length, length)),
+		Want:    needle,
+		Got:     haystack,
+	})
+	return false
+}
+
+// Matches passes and returns true if s contains a match of the regular
+// expression pattern. Use ^ and $ in the pattern to match all of s.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The test also fails if pattern is not
+// a valid regular expression.
+func Matches[S Text](t common.T, pattern string, s S) bool {
+	t.Helper()
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		report.Report(t, report.Failure{
+			Message: fmt.Sprintf("expected a valid regular expression\npattern: %s\n    err: %s", quotePattern(pattern), err),
+			Want:    pattern,
+		})
+		return false
+	}
+	haystack := string(s)
+	if re.MatchString(haystack) {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected s to match pattern\npattern: %s\n%s", quotePattern(pattern), markMatch("      s: ", haystack, 0, -1)),
+		Want:    pattern,
+		Got:     haystack,
+	})
+	return false
+}
+
+// closestMatch returns the position and length of the longest prefix of
+// needle that occurs in haystack.
+func closestMatch(needle, haystack string) (int, int) {
+	bestStart, bestLength := 0, 0
+	for start := 0; start < len(haystack) && bestLength < len(needle); start++ {
+		if length := commonPrefixLength(needle, haystack[start:]); length > bestLength {
+			bestStart, bestLength = start, length
+		}
+	}
+	return bestStart, bestLength
+}
+
+// commonPrefixLength returns the length in bytes of the longest common prefix
+// of a and b, without splitting a multi-byte rune.
+func commonPrefixLength(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	for n > 0 && n < len(b) && !utf8.RuneStart(b[n]) {
+		n--
+	}
+	return n
+}
+
+// commonSuffixLength returns the length in bytes of the longest common suffix
+// of a and b, without splitting a multi-byte rune.
+func commonSuffixLength(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[len(a)-1-n] == b[len(b)-1-n] {
+		n++
+	}
+	for n > 0 && n < len(b) && !utf8.RuneStart(b[len(b)-n]) {
+		n--
+	}
+	return n
+}
+
+// quote formats s as a quoted Go string, truncated to preview.MaxLength.
+func quote(s string) string {
+	return preview.Text(strconv.Quote(s))
+}
+
+// quotePattern formats a regular expression as a raw string if possible, so
+// that backslashes are not doubled.
+func quotePattern(pattern string) string {
+	if strconv.CanBackquote(pattern) {
+		return preview.Text("`" + pattern + "`")
+	}
+	return quote(pattern)
+}
+
+// markMatch formats the part of s around s[start:start+length] as a quoted
+// string following label, and marks the match with carets on the line below.
+// If length is 0, the position of start is marked with a single caret. If
+// length is negative, nothing is marked and s is shown from the beginning.
+func markMatch(label, s string, start, length int) string {
+	mark := length >= 0
+	if !mark {
+		start, length = 0, 0
+	}
+	windowStart := runeBoundary(s, start-matchContext)
+	windowEnd := runeBoundary(s, start+length+matchContext)
+	if !mark {
+		windowEnd = runeBoundary(s, preview.MaxLength)
+	}
+	prefix := label + `"`
+	if windowStart > 0 {
+		prefix = label + `..."`
+	}
+	before := quoteInner(s[windowStart:start])
+	match := quoteInner(s[start : start+length])
+	after := quoteInner(s[start+length : windowEnd])
+	line := prefix + before + match + after + `"`
+	if windowEnd < len(s) {
+		line += "..."
+	}
+	if !mark {
+		return line
+	}
+	width := utf8.RuneCountInString(match)
+	if width == 0 {
+		width = 1
+	}
+	indent := strings.Repeat(" ", utf8.RuneCountInString(prefix+before))
+	return line + "\n" + indent + strings.Repeat("^", width)
+}
+
+// quoteInner quotes s as a Go string, without the surrounding quotes.
+func quoteInner(s string) string {
+	quoted := strconv.Quote(s)
+	return quoted[1 : len(quoted)-1]
+}
+
+// runeBoundary clamps i to the bounds of s and moves it back to the start of
+// the rune that contains it.
+func runeBoundary(s string, i int) int {
+	if i <= 0 {
+		return 0
+	}
+	if i >= len(s) {
+		return len(s)
+	}
+	for i > 0 && !utf8.RuneStart(s[i]) {
+		i--
+	}
+	return i
+}
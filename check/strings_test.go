@@ -0,0 +1,87 @@
+package check_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+type path string
+
+func TestContains(t *testing.T) {
+	t.Parallel()
+	check.Contains(t, "ok", "status: ok")
+	check.Contains(t, "", "anything")
+	check.Contains(t, "ok", []byte("status: ok"))
+	check.Contains(t, []byte("ok"), "status: ok")
+	check.Contains(t, "usr", path("/usr/bin"))
+
+	for _, s := range []string{
+		"status: error",
+		"",
+		strings.Repeat("o", 1000) + "k",
+		"héllo",
+	} {
+		mt := &common.MockT{}
+		check.False(t, check.Contains(mt, "ok!", s))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	}
+}
+
+func TestNotContains(t *testing.T) {
+	t.Parallel()
+	check.NotContains(t, "error", "status: ok")
+	check.NotContains(t, "error", []byte("status: ok"))
+
+	mt := &common.MockT{}
+	check.False(t, check.NotContains(mt, "ok", strings.Repeat("lorem ipsum ", 100)+"ok"))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
+
+func TestHasPrefix(t *testing.T) {
+	t.Parallel()
+	check.HasPrefix(t, "/usr", "/usr/bin")
+	check.HasPrefix(t, "/usr", path("/usr/bin"))
+	check.HasPrefix(t, []byte{0x1f, 0x8b}, []byte{0x1f, 0x8b, 0x08})
+
+	for _, s := range []string{"/var/usr", "/us", ""} {
+		mt := &common.MockT{}
+		check.False(t, check.HasPrefix(mt, "/usr", s))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	}
+}
+
+func TestHasSuffix(t *testing.T) {
+	t.Parallel()
+	check.HasSuffix(t, ".go", "main.go")
+	check.HasSuffix(t, ".go", []byte("main.go"))
+
+	for _, s := range []string{"main.py", "go", "", "main.go\n"} {
+		mt := &common.MockT{}
+		check.False(t, check.HasSuffix(mt, ".go", s))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	}
+}
+
+func TestMatches(t *testing.T) {
+	t.Parallel()
+	check.Matches(t, `^\d{3}-\d{4}$`, "555-1234")
+	check.Matches(t, `error`, []byte("an error occurred"))
+
+	mt := &common.MockT{}
+	check.False(t, check.Matches(mt, `^\d{3}-\d{4}$`, "555-12345"))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+
+	// Invalid patterns always fail.
+	mt = &common.MockT{}
+	check.False(t, check.Matches(mt, `(`, "("))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
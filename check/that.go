@@ -0,0 +1,28 @@
+package check
+
+import (
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/match"
+	"github.com/peterldowns/testy/report"
+)
+
+// That passes and returns true if m matches got.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes the
+// matcher's explanation of why got did not match.
+//
+// See the match package for the built-in matchers, which can be composed to
+// express conditions like "every element has a non-empty Name".
+func That[T any](t common.T, got T, m match.Matcher[T]) bool {
+	t.Helper()
+	ok, explanation := m.Match(got)
+	if ok {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: "expected got to match\n" + explanation,
+		Got:     got,
+	})
+	return false
+}
@@ -0,0 +1,22 @@
+package check_test
+
+import (
+	"testing"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/match"
+)
+
+func TestThat(t *testing.T) {
+	t.Parallel()
+	check.That(t, []int{1, 2, 3}, match.AllOf(match.HasLen[[]int](3), match.Each(match.Not(match.Eq(0)))))
+
+	mt := &common.MockT{}
+	check.False(t, check.That(mt, []int{1, 0}, match.Each(match.Not(match.Eq(0)))))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+	check.Equal(t, []string{
+		"expected got to match\nelement [1] did not match:\n  want: not equal to 0\n   got: 0",
+	}, mt.Errors())
+}
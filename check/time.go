@@ -0,0 +1,83 @@
+package check
+
+import (
+	"fmt"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/report"
+)
+
+// WithinDuration passes and returns true if want and got are at most d apart.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes both
+// times and the signed difference between them.
+//
+// Monotonic clock readings are ignored, so that the comparison matches the
+// wall-clock times printed in the failure message.
+func WithinDuration(t common.T, want time.Time, got time.Time, d time.Duration) bool {
+	t.Helper()
+	diff := got.Round(0).Sub(want.Round(0))
+	if diff <= d && diff >= -d {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected want and got to be within %s\n%s", d, describeTimes(want, got)),
+		Want:    want,
+		Got:     got,
+	})
+	return false
+}
+
+// TimeEqual passes and returns true if want and got represent the same instant,
+// regardless of their locations or monotonic clock readings.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running. The failure message includes both
+// times and the signed difference between them.
+//
+// Unlike time.Time.Equal, this ignores monotonic clock readings even if both
+// times have them.
+func TimeEqual(t common.T, want time.Time, got time.Time) bool {
+	t.Helper()
+	if want.Round(0).Equal(got.Round(0)) {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected want and got to be the same instant\n%s", describeTimes(want, got)),
+		Want:    want,
+		Got:     got,
+	})
+	return false
+}
+
+// EquateTimesWithin returns a go-cmp Option that considers two non-zero
+// time.Time values equal if they are at most d apart. It can be passed to
+// Equal, NotEqual, In, and any other check that accepts go-cmp Options in
+// order to compare structs with timestamp fields:
+//
+//	check.Equal(t, want, got, check.EquateTimesWithin(time.Second))
+func EquateTimesWithin(d time.Duration) gocmp.Option {
+	return cmpopts.EquateApproxTime(d)
+}
+
+// describeTimes formats want and got in RFC3339Nano, along with the signed
+// difference got - want.
+func describeTimes(want, got time.Time) string {
+	diff := got.Round(0).Sub(want.Round(0))
+	sign := "+"
+	if diff < 0 {
+		sign = ""
+	}
+	return fmt.Sprintf(
+		"want: %s\n got: %s\ndiff: %s%s (got - want)",
+		want.Format(time.RFC3339Nano),
+		got.Format(time.RFC3339Nano),
+		sign,
+		diff,
+	)
+}
@@ -0,0 +1,60 @@
+package check_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+func TestWithinDuration(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	check.WithinDuration(t, now, now, 0)
+	check.WithinDuration(t, now, now.Add(time.Second), time.Second)
+	check.WithinDuration(t, now, now.Add(-time.Second), time.Second)
+	check.WithinDuration(t, now, now.UTC().Add(500*time.Millisecond), time.Second)
+
+	for _, got := range []time.Time{now.Add(1001 * time.Millisecond), now.Add(-time.Hour)} {
+		mt := &common.MockT{}
+		check.False(t, check.WithinDuration(mt, now, got, time.Second))
+		check.True(t, mt.Failed())
+		check.False(t, mt.FailedNow())
+	}
+}
+
+func TestTimeEqual(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	check.TimeEqual(t, now, now)
+	check.TimeEqual(t, now, now.UTC())
+	check.TimeEqual(t, now, now.In(time.FixedZone("UTC+8", 8*60*60)))
+
+	// Round(0) strips the monotonic clock reading. Time.Equal would compare
+	// the monotonic readings if both times had them.
+	check.TimeEqual(t, now, now.Round(0))
+
+	mt := &common.MockT{}
+	check.False(t, check.TimeEqual(mt, now, now.Add(time.Nanosecond)))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
+
+func TestEquateTimesWithin(t *testing.T) {
+	t.Parallel()
+	type event struct {
+		Name      string
+		CreatedAt time.Time
+	}
+	now := time.Now()
+	want := event{Name: "created", CreatedAt: now}
+	got := event{Name: "created", CreatedAt: now.Add(500 * time.Millisecond)}
+	check.Equal(t, want, got, check.EquateTimesWithin(time.Second))
+	check.NotEqual(t, want, got)
+
+	mt := &common.MockT{}
+	check.Equal(mt, want, got, check.EquateTimesWithin(time.Millisecond))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
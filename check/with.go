@@ -0,0 +1,23 @@
+package check
+
+import (
+	"fmt"
+
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/report"
+)
+
+// With returns a common.T that adds an annotation, formatted with
+// fmt.Sprintf, as a prefix to every failure reported through it. Pass it to
+// any function in check, assert, or golden, like you would t:
+//
+//	for _, id := range ids {
+//		t := check.With(t, "user %d", id)
+//		check.NoError(t, validate(id)) // user 3: expected <nil> error, ...
+//	}
+//
+// Annotations nest, so With(With(t, "user %d", 3), "order %d", 7) prefixes
+// failures with "user 3: order 7: ".
+func With(t common.T, format string, args ...any) common.T {
+	return report.Annotate(t, fmt.Sprintf(format, args...))
+}
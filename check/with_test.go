@@ -0,0 +1,55 @@
+package check_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+func TestWith(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	for id := 1; id <= 3; id++ {
+		wt := check.With(mt, "user %d", id)
+		check.True(wt, id != 2)
+	}
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+	check.Equal(t, []string{"user 2: expected true"}, mt.Errors())
+}
+
+func TestWithNested(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	wt := check.With(check.With(mt, "user %d", 3), "order %s", "abc")
+	check.NoError(wt, errors.New("oops"))
+	wt.Error("custom message")
+	check.Equal(t, []string{
+		`user 3: order abc: expected <nil> error, received &errors.errorString{s:"oops"}`,
+		"user 3: order abc: custom message",
+	}, mt.Errors())
+}
+
+func TestWithAssert(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	wt := check.With(mt, "step %d", 1)
+	assert.NoFailures(wt)
+	check.False(t, mt.Failed())
+
+	assert.Equal(wt, 1, 2)
+	check.True(t, mt.FailedNow())
+	check.Equal(t, 1, len(mt.Errors()))
+	check.HasPrefix(t, "step 1: expected want == got", mt.Errors()[0])
+
+	mt = &common.MockT{}
+	wt = check.With(mt, "step %d", 2)
+	assert.NoFailures(wt, func() {
+		check.True(wt, false)
+	})
+	check.True(t, mt.FailedNow())
+	check.Equal(t, []string{"step 2: expected true"}, mt.Errors())
+}
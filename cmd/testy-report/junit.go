@@ -0,0 +1,127 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/peterldowns/testy/report"
+)
+
+// The JUnit XML format, as understood by most CI systems.
+type (
+	junitSuites struct {
+		XMLName xml.Name     `xml:"testsuites"`
+		Suites  []junitSuite `xml:"testsuite"`
+	}
+	junitSuite struct {
+		Name     string      `xml:"name,attr"`
+		Tests    int         `xml:"tests,attr"`
+		Failures int         `xml:"failures,attr"`
+		Skipped  int         `xml:"skipped,attr"`
+		Time     string      `xml:"time,attr"`
+		Cases    []junitCase `xml:"testcase"`
+	}
+	junitCase struct {
+		ClassName string         `xml:"classname,attr"`
+		Name      string         `xml:"name,attr"`
+		Time      string         `xml:"time,attr"`
+		Failures  []junitFailure `xml:"failure,omitempty"`
+		Skipped   *struct{}      `xml:"skipped,omitempty"`
+	}
+	junitFailure struct {
+		Message string `xml:"message,attr"`
+		Type    string `xml:"type,attr,omitempty"`
+		Body    string `xml:",chardata"`
+	}
+)
+
+// writeJUnitFile writes the summaries to path as a JUnit XML report.
+func writeJUnitFile(path string, summaries []*Summary) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := writeJUnit(file, summaries); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// writeJUnit writes the summaries as a JUnit XML report, with a test suite for
+// each package and a test case for each test. Every Testy failure record of a
+// test is a separate failure element whose body is the location and the full
+// failure message, which describes want, got, and their diff.
+func writeJUnit(w io.Writer, summaries []*Summary) error {
+	suites := junitSuites{}
+	for _, s := range summaries {
+		records := map[string][]report.Record{}
+		for _, r := range s.Failures {
+			records[r.Test] = append(records[r.Test], r)
+		}
+		suite := junitSuite{
+			Name:     s.Package,
+			Tests:    len(s.Tests),
+			Failures: s.Failed,
+			Skipped:  s.Skipped,
+			Time:     seconds(s.Elapsed),
+		}
+		for _, test := range s.Tests {
+			c := junitCase{
+				ClassName: s.Package,
+				Name:      test.Name,
+				Time:      seconds(test.Elapsed),
+			}
+			switch test.Result {
+			case "skip":
+				c.Skipped = &struct{}{}
+			case "fail":
+				c.Failures = junitFailures(test, records[test.Name])
+			}
+			suite.Cases = append(suite.Cases, c)
+		}
+		suites.Suites = append(suites.Suites, suite)
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// junitFailures returns the failure elements for a failed test. If Testy
+// didn't record any failures, for instance because the test called t.Fatal,
+// the test's output is used instead.
+func junitFailures(test *TestResult, records []report.Record) []junitFailure {
+	if len(records) == 0 {
+		return []junitFailure{{Message: "test failed", Body: test.Output}}
+	}
+	failures := make([]junitFailure, 0, len(records))
+	for _, r := range records {
+		summary, _, _ := strings.Cut(r.Message, "\n")
+		var body strings.Builder
+		fmt.Fprintf(&body, "%s:%d\n%s\n", relative(r.File), r.Line, r.Message)
+		if r.Diff != "" && !strings.Contains(r.Message, r.Diff) {
+			fmt.Fprintf(&body, "diff:\n%s\n", r.Diff)
+		}
+		failures = append(failures, junitFailure{
+			Message: summary,
+			Type:    r.Check,
+			Body:    body.String(),
+		})
+	}
+	return failures
+}
+
+// seconds formats a duration in seconds like go test does.
+func seconds(elapsed float64) string {
+	return fmt.Sprintf("%.3f", elapsed)
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+)
+
+func TestJUnit(t *testing.T) {
+	t.Parallel()
+	junitPath := filepath.Join(t.TempDir(), "junit.xml")
+	err := run([]string{"-records", writeRecords(t), "-junit", junitPath}, strings.NewReader(events), &bytes.Buffer{})
+	assert.NoError(t, err)
+	data, err := os.ReadFile(junitPath)
+	assert.NoError(t, err)
+	check.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="example.com/a" tests="3" failures="1" skipped="1" time="0.250">
+    <testcase classname="example.com/a" name="TestOne" time="0.010">
+      <failure message="expected want == got" type="check.Equal">/src/a/a_test.go:10&#xA;expected want == got&#xA;want: 1&#xA; got: 2&#xA;</failure>
+      <failure message="expected &lt;nil&gt; error" type="assert.NoError">/src/a/a_test.go:12&#xA;expected &lt;nil&gt; error&#xA;</failure>
+      <failure message="expected want == got" type="check.Equal">/src/a/a_test.go:11&#xA;expected want == got&#xA;</failure>
+    </testcase>
+    <testcase classname="example.com/a" name="TestTwo" time="0.000"></testcase>
+    <testcase classname="example.com/a" name="TestThree" time="0.000">
+      <skipped></skipped>
+    </testcase>
+  </testsuite>
+  <testsuite name="example.com/b" tests="1" failures="0" skipped="0" time="0.500">
+    <testcase classname="example.com/b" name="TestFour" time="0.000"></testcase>
+  </testsuite>
+</testsuites>
+`, string(data))
+}
+
+func TestJUnitWithoutRecords(t *testing.T) {
+	t.Parallel()
+	var out bytes.Buffer
+	summaries := summarize(mustReadEvents(t, events), nil)
+	assert.NoError(t, writeJUnit(&out, summaries))
+	check.Contains(t, `<failure message="test failed">=== RUN   TestOne&#xA;</failure>`, out.String())
+}
+
+func mustReadEvents(t *testing.T, s string) []event {
+	t.Helper()
+	events, err := readEvents(strings.NewReader(s))
+	assert.NoError(t, err)
+	return events
+}
@@ -0,0 +1,258 @@
+// Command testy-report joins the failure records that Testy writes to the
+// file named by TESTY_REPORT with the output of `go test -json`, and prints a
+// summary of each package: how many tests passed, failed, and were skipped,
+// and which checks failed where.
+//
+//	TESTY_REPORT=$PWD/testy.ndjson go test -json ./... > test.json
+//	go run github.com/peterldowns/testy/cmd/testy-report -records testy.ndjson < test.json
+//
+// Pass -json to print the summary as JSON instead of text, and -junit to also
+// write a JUnit XML report that includes the want, got, and diff of every
+// failure.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/peterldowns/testy/report"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "testy-report: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// event is a single line of `go test -json` output.
+type event struct {
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+// Summary describes the results of testing a single package.
+type Summary struct {
+	Package string  `json:"package"`
+	Result  string  `json:"result,omitempty"`
+	Elapsed float64 `json:"elapsed,omitempty"`
+	Passed  int     `json:"passed"`
+	Failed  int     `json:"failed"`
+	Skipped int     `json:"skipped"`
+	// Checks counts the failures of each kind of check.
+	Checks   map[string]int  `json:"checks,omitempty"`
+	Failures []report.Record `json:"failures,omitempty"`
+	Tests    []*TestResult   `json:"tests,omitempty"`
+}
+
+// TestResult describes the result of a single test.
+type TestResult struct {
+	Name    string  `json:"name"`
+	Result  string  `json:"result"`
+	Elapsed float64 `json:"elapsed"`
+	// Output is everything the test printed, which is included in the JUnit
+	// report for tests that failed without a Testy failure record.
+	Output string `json:"-"`
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("testy-report", flag.ContinueOnError)
+	recordsPath := flags.String("records", os.Getenv(report.RecordEnv), "the file of failure records written by Testy")
+	asJSON := flags.Bool("json", false, "print the summary as JSON")
+	junitPath := flags.String("junit", "", "also write a JUnit XML report to this file")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	input := stdin
+	if flags.NArg() > 0 {
+		file, err := os.Open(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		input = file
+	}
+	events, err := readEvents(input)
+	if err != nil {
+		return err
+	}
+	var records []report.Record
+	if *recordsPath != "" {
+		records, err = readRecords(*recordsPath)
+		if err != nil {
+			return err
+		}
+	}
+	summaries := summarize(events, records)
+	if *junitPath != "" {
+		if err := writeJUnitFile(*junitPath, summaries); err != nil {
+			return err
+		}
+	}
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(summaries)
+	}
+	return writeText(stdout, summaries)
+}
+
+// readEvents parses `go test -json` output, ignoring any lines that aren't
+// JSON, like build errors.
+func readEvents(r io.Reader) ([]event, error) {
+	var events []event
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 16*1024*1024)
+	for scanner.Scan() {
+		var e event
+		if json.Unmarshal(scanner.Bytes(), &e) == nil && e.Action != "" {
+			events = append(events, e)
+		}
+	}
+	return events, scanner.Err()
+}
+
+// readRecords parses the failure records in the file at path.
+func readRecords(path string) ([]report.Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var records []report.Record
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		var r report.Record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		records = append(records, r)
+	}
+	return records, scanner.Err()
+}
+
+// summarize joins the test events and failure records into a summary of each
+// package, sorted by package.
+func summarize(events []event, records []report.Record) []*Summary {
+	byPackage := map[string]*Summary{}
+	get := func(pkg string) *Summary {
+		s, ok := byPackage[pkg]
+		if !ok {
+			s = &Summary{Package: pkg, Checks: map[string]int{}}
+			byPackage[pkg] = s
+		}
+		return s
+	}
+	outputs := map[[2]string]*strings.Builder{}
+	for _, e := range events {
+		s := get(e.Package)
+		if e.Test == "" {
+			if e.Action == "pass" || e.Action == "fail" || e.Action == "skip" {
+				s.Result = e.Action
+				s.Elapsed = e.Elapsed
+			}
+			continue
+		}
+		key := [2]string{e.Package, e.Test}
+		switch e.Action {
+		case "output":
+			if outputs[key] == nil {
+				outputs[key] = &strings.Builder{}
+			}
+			outputs[key].WriteString(e.Output)
+			continue
+		case "pass":
+			s.Passed++
+		case "fail":
+			s.Failed++
+		case "skip":
+			s.Skipped++
+		default:
+			continue
+		}
+		test := &TestResult{Name: e.Test, Result: e.Action, Elapsed: e.Elapsed}
+		if output := outputs[key]; output != nil {
+			test.Output = output.String()
+		}
+		s.Tests = append(s.Tests, test)
+	}
+	for _, r := range records {
+		s := get(r.Package)
+		s.Checks[r.Check]++
+		s.Failures = append(s.Failures, r)
+	}
+	summaries := make([]*Summary, 0, len(byPackage))
+	for _, s := range byPackage {
+		summaries = append(summaries, s)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Package < summaries[j].Package
+	})
+	return summaries
+}
+
+// writeText prints the summaries in a format similar to `go test`.
+func writeText(w io.Writer, summaries []*Summary) error {
+	b := &strings.Builder{}
+	for _, s := range summaries {
+		result := "?"
+		switch s.Result {
+		case "pass":
+			result = "ok"
+		case "fail":
+			result = "FAIL"
+		case "skip":
+			result = "skip"
+		}
+		fmt.Fprintf(b, "%-4s %s", result, s.Package)
+		if s.Result != "" {
+			fmt.Fprintf(b, " (%.2fs)", s.Elapsed)
+		}
+		fmt.Fprintf(b, "\n     tests: %d passed, %d failed, %d skipped\n", s.Passed, s.Failed, s.Skipped)
+		if len(s.Failures) == 0 {
+			continue
+		}
+		b.WriteString("     failures by check:\n")
+		checks := make([]string, 0, len(s.Checks))
+		for check := range s.Checks {
+			checks = append(checks, check)
+		}
+		sort.Strings(checks)
+		for _, check := range checks {
+			fmt.Fprintf(b, "       %s: %d\n", check, s.Checks[check])
+		}
+		b.WriteString("     failures:\n")
+		for _, r := range s.Failures {
+			summary, _, _ := strings.Cut(r.Message, "\n")
+			fmt.Fprintf(b, "       %s %s:%d: %s: %s\n", r.Test, relative(r.File), r.Line, r.Check, summary)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// relative returns path relative to the working directory, if it is inside
+// it.
+func relative(path string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return path
+	}
+	if rel, ok := strings.CutPrefix(path, wd+string(os.PathSeparator)); ok {
+		return rel
+	}
+	return path
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+)
+
+const events = `{"Action":"start","Package":"example.com/a"}
+{"Action":"run","Package":"example.com/a","Test":"TestOne"}
+{"Action":"output","Package":"example.com/a","Test":"TestOne","Output":"=== RUN   TestOne\n"}
+{"Action":"fail","Package":"example.com/a","Test":"TestOne","Elapsed":0.01}
+{"Action":"pass","Package":"example.com/a","Test":"TestTwo","Elapsed":0}
+{"Action":"skip","Package":"example.com/a","Test":"TestThree","Elapsed":0}
+{"Action":"fail","Package":"example.com/a","Elapsed":0.25}
+not json, like a build error
+{"Action":"pass","Package":"example.com/b","Test":"TestFour","Elapsed":0}
+{"Action":"pass","Package":"example.com/b","Elapsed":0.5}
+`
+
+const records = `{"package":"example.com/a","test":"TestOne","check":"check.Equal","file":"/src/a/a_test.go","line":10,"message":"expected want == got\nwant: 1\n got: 2","want":"1","got":"2"}
+
+{"package":"example.com/a","test":"TestOne","check":"assert.NoError","fatal":true,"file":"/src/a/a_test.go","line":12,"message":"expected <nil> error"}
+{"package":"example.com/a","test":"TestOne","check":"check.Equal","file":"/src/a/a_test.go","line":11,"message":"expected want == got"}
+`
+
+func writeRecords(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "testy.ndjson")
+	assert.NoError(t, os.WriteFile(path, []byte(records), 0o600))
+	return path
+}
+
+func TestRunText(t *testing.T) {
+	t.Parallel()
+	var out bytes.Buffer
+	err := run([]string{"-records", writeRecords(t)}, strings.NewReader(events), &out)
+	assert.NoError(t, err)
+	check.Equal(t, strings.Join([]string{
+		"FAIL example.com/a (0.25s)",
+		"     tests: 1 passed, 1 failed, 1 skipped",
+		"     failures by check:",
+		"       assert.NoError: 1",
+		"       check.Equal: 2",
+		"     failures:",
+		"       TestOne /src/a/a_test.go:10: check.Equal: expected want == got",
+		"       TestOne /src/a/a_test.go:12: assert.NoError: expected <nil> error",
+		"       TestOne /src/a/a_test.go:11: check.Equal: expected want == got",
+		"ok   example.com/b (0.50s)",
+		"     tests: 1 passed, 0 failed, 0 skipped",
+		"",
+	}, "\n"), out.String())
+}
+
+func TestRunJSON(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	eventsPath := filepath.Join(dir, "test.json")
+	assert.NoError(t, os.WriteFile(eventsPath, []byte(events), 0o600))
+
+	var out bytes.Buffer
+	err := run([]string{"-json", "-records", writeRecords(t), eventsPath}, strings.NewReader(""), &out)
+	assert.NoError(t, err)
+	var summaries []Summary
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &summaries))
+	assert.Equal(t, 2, len(summaries))
+
+	a := summaries[0]
+	check.Equal(t, "example.com/a", a.Package)
+	check.Equal(t, "fail", a.Result)
+	check.Equal(t, 1, a.Failed)
+	check.Equal(t, map[string]int{"check.Equal": 2, "assert.NoError": 1}, a.Checks)
+	check.Equal(t, 3, len(a.Failures))
+	check.Equal(t, "2", a.Failures[0].Got)
+
+	b := summaries[1]
+	check.Equal(t, "example.com/b", b.Package)
+	check.Equal(t, "pass", b.Result)
+	check.Equal(t, 0, len(b.Failures))
+	check.Equal(t, 0, len(b.Checks))
+}
+
+func TestRunBadRecords(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "testy.ndjson")
+	assert.NoError(t, os.WriteFile(path, []byte("{\n"), 0o600))
+	err := run([]string{"-records", path}, strings.NewReader(events), &bytes.Buffer{})
+	check.ErrorContains(t, err, "testy.ndjson:1")
+}
@@ -0,0 +1,279 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// MockT is designed to be used in tests to make sure that Testy fails in the
+// appropriate ways. It implements all of TB, and records every message passed
+// to Error, Log, and Skip, along with the line that logged it, skipping
+// functions marked with Helper like *testing.T does.
+//
+// Outside of Run, FailNow and Skip mark the test and return, so that the
+// caller can keep going and inspect the result. Inside Run, they stop the body
+// with runtime.Goexit, like *testing.T does, and the functions registered with
+// Cleanup are called once the body is done.
+//
+// A MockT is safe for concurrent use.
+type MockT struct {
+	// TestName is returned by Name. It's empty by default, so that Testy
+	// treats the MockT like a T without a name.
+	TestName string
+
+	mu        sync.Mutex
+	failed    bool
+	failednow bool
+	skipped   bool
+	messages  []Message
+	helpers   map[string]struct{}
+	running   int
+	cleanups  []func()
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+// Message is a message passed to Error, Log, or Skip.
+type Message struct {
+	Text  string // the message, formatted like fmt.Sprintln without the newline
+	Error bool   // true if the message was passed to Error, false otherwise
+	File  string // the file that logged the message, skipping helpers
+	Line  int    // the line that logged the message, skipping helpers
+}
+
+var _ TB = (*MockT)(nil)
+
+func (t *MockT) Failed() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.failed
+}
+
+func (t *MockT) FailedNow() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.failednow
+}
+
+// Skipped returns true if Skip was called.
+func (t *MockT) Skipped() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.skipped
+}
+
+// Messages returns every message passed to Error, Log, and Skip, in order.
+func (t *MockT) Messages() []Message {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]Message(nil), t.messages...)
+}
+
+// Errors returns the text of every message passed to Error, in order.
+func (t *MockT) Errors() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var errors []string
+	for _, m := range t.messages {
+		if m.Error {
+			errors = append(errors, m.Text)
+		}
+	}
+	return errors
+}
+
+func (t *MockT) Fail() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.failed = true
+}
+
+func (t *MockT) FailNow() {
+	t.mu.Lock()
+	t.failed = true
+	t.failednow = true
+	t.mu.Unlock()
+	t.exit()
+}
+
+func (t *MockT) Log(args ...any) {
+	t.log(false, fmt.Sprintln(args...))
+}
+
+func (t *MockT) Logf(format string, args ...any) {
+	t.log(false, fmt.Sprintf(format, args...))
+}
+
+func (t *MockT) Error(args ...any) {
+	t.log(true, fmt.Sprintln(args...))
+	t.Fail()
+}
+
+// Skip logs args and marks the test as skipped.
+func (t *MockT) Skip(args ...any) {
+	t.log(false, fmt.Sprintln(args...))
+	t.mu.Lock()
+	t.skipped = true
+	t.mu.Unlock()
+	t.exit()
+}
+
+// Helper marks the calling function as a helper, so that messages logged from
+// within it are attributed to its caller instead.
+func (t *MockT) Helper() {
+	name := callerName(2)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.helpers == nil {
+		t.helpers = map[string]struct{}{}
+	}
+	t.helpers[name] = struct{}{}
+}
+
+func (t *MockT) Name() string {
+	return t.TestName
+}
+
+// Cleanup registers fn to be called when Run finishes, after any functions
+// registered before it.
+func (t *MockT) Cleanup(fn func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cleanups = append(t.cleanups, fn)
+}
+
+// TempDir creates a new temporary directory, which is removed when Run
+// finishes.
+func (t *MockT) TempDir() string {
+	t.Helper()
+	dir, err := os.MkdirTemp("", "MockT")
+	if err != nil {
+		t.Error(fmt.Sprintf("TempDir: %s", err))
+		t.FailNow()
+		return ""
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+// Setenv sets an environment variable, which is restored when Run finishes.
+// Like the one on *testing.T, it affects the whole process.
+func (t *MockT) Setenv(key, value string) {
+	t.Helper()
+	previous, ok := os.LookupEnv(key)
+	if err := os.Setenv(key, value); err != nil {
+		t.Error(fmt.Sprintf("Setenv: %s", err))
+		t.FailNow()
+		return
+	}
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, previous)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+// Context returns a context that is canceled when Run finishes, just before
+// the functions registered with Cleanup are called.
+func (t *MockT) Context() context.Context {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.ctx == nil {
+		t.ctx, t.cancel = context.WithCancel(context.Background())
+	}
+	return t.ctx
+}
+
+// Run calls fn with t on a new goroutine and waits for it to return, call
+// FailNow, or call Skip. Then it cancels t's context and calls the functions
+// registered with Cleanup. It returns true if t has not failed.
+func (t *MockT) Run(fn func(t *MockT)) bool {
+	t.mu.Lock()
+	t.running++
+	t.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer t.runCleanups()
+		fn(t)
+	}()
+	<-done
+	t.mu.Lock()
+	t.running--
+	t.mu.Unlock()
+	return !t.Failed()
+}
+
+// runCleanups cancels t's context and calls the functions registered with
+// Cleanup in reverse order. Each one is called from a deferred call, so the
+// rest still run if one stops with FailNow.
+func (t *MockT) runCleanups() {
+	t.mu.Lock()
+	if t.cancel != nil {
+		t.cancel()
+	}
+	var fn func()
+	if n := len(t.cleanups); n > 0 {
+		fn = t.cleanups[n-1]
+		t.cleanups = t.cleanups[:n-1]
+	}
+	t.mu.Unlock()
+	if fn == nil {
+		return
+	}
+	defer t.runCleanups()
+	fn()
+}
+
+// exit stops the body passed to Run.
+func (t *MockT) exit() {
+	t.mu.Lock()
+	running := t.running > 0
+	t.mu.Unlock()
+	if running {
+		runtime.Goexit()
+	}
+}
+
+func (t *MockT) log(isError bool, text string) {
+	file, line := t.caller()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.messages = append(t.messages, Message{
+		Text:  strings.TrimSuffix(text, "\n"),
+		Error: isError,
+		File:  file,
+		Line:  line,
+	})
+}
+
+// caller returns the location of the first function on the stack, above
+// Error, Log, Logf, or Skip, that hasn't been marked as a helper.
+func (t *MockT) caller() (string, int) {
+	pcs := make([]uintptr, 50)
+	n := runtime.Callers(4, pcs) // runtime.Callers, caller, log, Error or Log
+	frames := runtime.CallersFrames(pcs[:n])
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var frame runtime.Frame
+	for more := true; more; {
+		frame, more = frames.Next()
+		if _, ok := t.helpers[frame.Function]; !ok {
+			break
+		}
+	}
+	return frame.File, frame.Line
+}
+
+func callerName(skip int) string {
+	pcs := make([]uintptr, 1)
+	runtime.Callers(skip+1, pcs)
+	frame, _ := runtime.CallersFrames(pcs).Next()
+	return frame.Function
+}
@@ -0,0 +1,148 @@
+package common_test
+
+import (
+	"os"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+func TestMockTMessages(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	_, file, line, _ := runtime.Caller(0)
+	mt.Log("hello", 1)
+	mt.Error("oops")
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+	check.Equal(t, []common.Message{
+		{Text: "hello 1", File: file, Line: line + 1},
+		{Text: "oops", Error: true, File: file, Line: line + 2},
+	}, mt.Messages())
+}
+
+func TestMockTErrors(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	check.Equal(t, 0, len(mt.Errors()))
+	mt.Error("oops")
+	mt.Log("hello")
+	mt.Error("oh", "no")
+	check.Equal(t, []string{"oops", "oh no"}, mt.Errors())
+}
+
+func TestMockTHelper(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	_, file, line, _ := runtime.Caller(0)
+	check.Equal(mt, 1, 2)
+	helper(mt)
+	messages := mt.Messages()
+	assert.Equal(t, 2, len(messages))
+	for i, m := range messages {
+		check.Equal(t, file, m.File)
+		check.Equal(t, line+1+i, m.Line)
+	}
+}
+
+func helper(t common.T) {
+	t.Helper()
+	t.Error("from a helper")
+}
+
+func TestMockTRun(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	reached := false
+	check.False(t, mt.Run(func(mt *common.MockT) {
+		assert.True(mt, false)
+		reached = true
+	}))
+	check.False(t, reached)
+	check.True(t, mt.FailedNow())
+
+	// Outside of Run, FailNow returns.
+	mt = &common.MockT{}
+	assert.True(mt, false)
+	check.True(t, mt.FailedNow())
+
+	check.True(t, (&common.MockT{}).Run(func(mt *common.MockT) {
+		check.True(mt, true)
+	}))
+}
+
+func TestMockTConcurrent(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			check.True(mt, false)
+			mt.Log("done")
+		}()
+	}
+	wg.Wait()
+	check.True(t, mt.Failed())
+	check.Equal(t, 20, len(mt.Messages()))
+}
+
+func TestMockTCleanup(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{TestName: "TestSomething"}
+	check.Equal(t, "TestSomething", mt.Name())
+	var calls []string
+	var dir string
+	mt.Run(func(mt *common.MockT) {
+		ctx := mt.Context()
+		dir = mt.TempDir()
+		mt.Cleanup(func() {
+			calls = append(calls, "first")
+		})
+		mt.Cleanup(func() {
+			check.Error(t, ctx.Err())
+			calls = append(calls, "second")
+			mt.FailNow()
+		})
+		check.NoError(t, ctx.Err())
+		check.True(t, isDir(dir))
+	})
+	check.Equal(t, []string{"second", "first"}, calls)
+	check.False(t, isDir(dir))
+	check.True(t, mt.FailedNow())
+}
+
+func TestMockTSetenv(t *testing.T) { //nolint:paralleltest // modifies the environment
+	const key = "TESTY_MOCKT_SETENV"
+	mt := &common.MockT{}
+	mt.Run(func(mt *common.MockT) {
+		mt.Setenv(key, "1")
+		check.Equal(t, "1", os.Getenv(key))
+	})
+	_, ok := os.LookupEnv(key)
+	check.False(t, ok)
+}
+
+func TestMockTSkip(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	reached := false
+	check.True(t, mt.Run(func(mt *common.MockT) {
+		mt.Skip("not today")
+		reached = true
+	}))
+	check.False(t, reached)
+	check.True(t, mt.Skipped())
+	check.False(t, mt.Failed())
+	check.Equal(t, "not today", mt.Messages()[0].Text)
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
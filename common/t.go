@@ -12,39 +12,3 @@ type T interface {
 	Helper()      // mark as a helper
 	Failed() bool // yes if the test has failed
 }
-
-// MockT is designed to be used in tests to make sure that Testy fails in the
-// appropriate ways.
-type MockT struct {
-	failed    bool
-	failednow bool
-}
-
-func (t *MockT) Failed() bool {
-	return t.failed
-}
-
-func (t *MockT) FailedNow() bool {
-	return t.failednow
-}
-
-func (t *MockT) Fail() {
-	t.failed = true
-}
-
-func (t *MockT) FailNow() {
-	t.Fail()
-	t.failednow = true
-}
-
-func (*MockT) Log(_ ...any) {
-	// no-op
-}
-
-func (t *MockT) Error(_ ...any) {
-	t.Fail()
-}
-
-func (*MockT) Helper() {
-	// no-op
-}
@@ -0,0 +1,131 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// TB extends T with the rest of the methods on *testing.T that are useful to
+// helpers: logging, naming, cleanup, and the test's environment. *testing.T and
+// *testing.B implement TB as of Go 1.24, which added Context. MockT implements
+// it too.
+//
+// Testy functions take a T, and use the methods of TB through [AsTB] so that
+// they keep working with a T that only has some of them.
+type TB interface {
+	T
+	Log(args ...any)                 // log a message
+	Logf(format string, args ...any) // log a formatted message
+	Name() string                    // the name of the test
+	Cleanup(fn func())               // call fn when the test finishes
+	TempDir() string                 // a directory that's removed when the test finishes
+	Setenv(key, value string)        // set an environment variable until the test finishes
+	Skip(args ...any)                // log a message, mark as skipped, exit
+	Context() context.Context        // canceled just before the test finishes
+}
+
+// AsTB returns t as a TB. If t doesn't implement all of TB, each method that t
+// does have is called directly, and the rest fall back to doing as much as they
+// can without it:
+//
+//   - Log and Logf do nothing.
+//   - Name returns "".
+//   - Cleanup does nothing, so fn is never called.
+//   - TempDir creates a directory, which is removed by Cleanup if t has it.
+//   - Setenv sets the variable, which is restored by Cleanup if t has it.
+//   - Skip logs args and returns without stopping the test.
+//   - Context returns context.Background().
+func AsTB(t T) TB {
+	if tb, ok := t.(TB); ok {
+		return tb
+	}
+	return partialTB{t}
+}
+
+// partialTB fills in the methods of TB that its T doesn't have.
+type partialTB struct {
+	T
+}
+
+func (t partialTB) Log(args ...any) {
+	t.T.Helper()
+	if l, ok := t.T.(interface{ Log(args ...any) }); ok {
+		l.Log(args...)
+	}
+}
+
+func (t partialTB) Logf(format string, args ...any) {
+	t.T.Helper()
+	if l, ok := t.T.(interface {
+		Logf(format string, args ...any)
+	}); ok {
+		l.Logf(format, args...)
+		return
+	}
+	t.Log(fmt.Sprintf(format, args...))
+}
+
+func (t partialTB) Name() string {
+	if n, ok := t.T.(interface{ Name() string }); ok {
+		return n.Name()
+	}
+	return ""
+}
+
+func (t partialTB) Cleanup(fn func()) {
+	if c, ok := t.T.(interface{ Cleanup(fn func()) }); ok {
+		c.Cleanup(fn)
+	}
+}
+
+func (t partialTB) TempDir() string {
+	t.T.Helper()
+	if d, ok := t.T.(interface{ TempDir() string }); ok {
+		return d.TempDir()
+	}
+	dir, err := os.MkdirTemp("", "testy")
+	if err != nil {
+		t.Error(fmt.Sprintf("TempDir: %s", err))
+		t.FailNow()
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func (t partialTB) Setenv(key, value string) {
+	t.T.Helper()
+	if s, ok := t.T.(interface{ Setenv(key, value string) }); ok {
+		s.Setenv(key, value)
+		return
+	}
+	previous, ok := os.LookupEnv(key)
+	if err := os.Setenv(key, value); err != nil {
+		t.Error(fmt.Sprintf("Setenv: %s", err))
+		t.FailNow()
+		return
+	}
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, previous)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+func (t partialTB) Skip(args ...any) {
+	t.T.Helper()
+	if s, ok := t.T.(interface{ Skip(args ...any) }); ok {
+		s.Skip(args...)
+		return
+	}
+	t.Log(args...)
+}
+
+func (t partialTB) Context() context.Context {
+	if c, ok := t.T.(interface{ Context() context.Context }); ok {
+		return c.Context()
+	}
+	return context.Background()
+}
@@ -0,0 +1,62 @@
+package common_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+// minimalT only has the methods of common.T.
+type minimalT struct {
+	common.T
+}
+
+func TestAsTB(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	check.True(t, common.AsTB(mt) == common.TB(mt))
+
+	tb := common.AsTB(minimalT{mt})
+	tb.Log("hello")
+	tb.Logf("hello %s", "world")
+	tb.Cleanup(func() { t.Error("never called") })
+	tb.Skip("skipping")
+	check.Equal(t, "", tb.Name())
+	check.True(t, tb.Context() == context.Background())
+	dir := tb.TempDir()
+	defer os.RemoveAll(dir)
+	check.True(t, isDir(dir))
+	check.False(t, mt.Failed())
+	check.Equal(t, 0, len(mt.Messages()))
+}
+
+func TestAsTBPartial(t *testing.T) {
+	t.Parallel()
+	// A T with some of the methods of TB uses them.
+	mt := &common.MockT{TestName: "TestPartial"}
+	tb := common.AsTB(loggingT{minimalT{mt}, mt})
+	tb.Logf("hello %s", "world")
+	check.Equal(t, "TestPartial", tb.Name())
+	check.Equal(t, []string{"hello world"}, texts(mt.Messages()))
+}
+
+// loggingT has the methods of common.T, Log, and Name.
+type loggingT struct {
+	minimalT
+	mt *common.MockT
+}
+
+func (t loggingT) Log(args ...any) { t.mt.Log(args...) }
+
+func (t loggingT) Name() string { return t.mt.Name() }
+
+func texts(messages []common.Message) []string {
+	var texts []string
+	for _, m := range messages {
+		texts = append(texts, m.Text)
+	}
+	return texts
+}
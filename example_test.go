@@ -1,4 +1,4 @@
-package main_test
+package testy_test
 
 import (
 	"fmt"
@@ -30,6 +30,11 @@ func TestChecks(t *testing.T) {
 	check.Nil(t, nilm)
 	nilm = map[string]string{"hello": "world"}
 	check.NotNil(t, nilm)
+
+	check.Zero(t, 0)
+	check.NotZero(t, "hello")
+	check.Empty(t, []int{})
+	check.NotEmpty(t, map[string]int{"hello": 1})
 }
 
 func TestAsserts(t *testing.T) {
@@ -54,4 +59,9 @@ func TestAsserts(t *testing.T) {
 	assert.Nil(t, nilm)
 	nilm = map[string]string{"hello": "world"}
 	assert.NotNil(t, nilm)
+
+	assert.Zero(t, 0)
+	assert.NotZero(t, "hello")
+	assert.Empty(t, []int{})
+	assert.NotEmpty(t, map[string]int{"hello": 1})
 }
@@ -0,0 +1,565 @@
+package testy
+
+import (
+	"cmp"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/match"
+)
+
+// Equal passes if want == got. With a Check handle, it calls [check.Equal] and
+// returns true if it passed. With an Assert handle, it calls [assert.Equal],
+// which stops the test if it fails.
+func Equal[Type any](h Handle, want Type, got Type, opts ...gocmp.Option) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.Equal(t, want, got, opts...)
+		return true
+	}
+	return check.Equal(t, want, got, opts...)
+}
+
+// NotEqual passes if want != got. With a Check handle, it calls
+// [check.NotEqual] and returns true if it passed. With an Assert handle, it
+// calls [assert.NotEqual], which stops the test if it fails.
+func NotEqual[Type any](h Handle, want Type, got Type, opts ...gocmp.Option) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.NotEqual(t, want, got, opts...)
+		return true
+	}
+	return check.NotEqual(t, want, got, opts...)
+}
+
+// LessThan passes if small < big. With a Check handle, it calls
+// [check.LessThan] and returns true if it passed. With an Assert handle, it
+// calls [assert.LessThan], which stops the test if it fails.
+func LessThan[Type cmp.Ordered](h Handle, small Type, big Type) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.LessThan(t, small, big)
+		return true
+	}
+	return check.LessThan(t, small, big)
+}
+
+// LessThanOrEqual passes if small <= big. With a Check handle, it calls
+// [check.LessThanOrEqual] and returns true if it passed. With an Assert handle,
+// it calls [assert.LessThanOrEqual], which stops the test if it fails.
+func LessThanOrEqual[Type cmp.Ordered](h Handle, small Type, big Type) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.LessThanOrEqual(t, small, big)
+		return true
+	}
+	return check.LessThanOrEqual(t, small, big)
+}
+
+// GreaterThan passes if big > small. With a Check handle, it calls
+// [check.GreaterThan] and returns true if it passed. With an Assert handle, it
+// calls [assert.GreaterThan], which stops the test if it fails.
+func GreaterThan[Type cmp.Ordered](h Handle, big Type, small Type) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.GreaterThan(t, big, small)
+		return true
+	}
+	return check.GreaterThan(t, big, small)
+}
+
+// GreaterThanOrEqual passes if big >= small. With a Check handle, it calls
+// [check.GreaterThanOrEqual] and returns true if it passed. With an Assert
+// handle, it calls [assert.GreaterThanOrEqual], which stops the test if it
+// fails.
+func GreaterThanOrEqual[Type cmp.Ordered](h Handle, big Type, small Type) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.GreaterThanOrEqual(t, big, small)
+		return true
+	}
+	return check.GreaterThanOrEqual(t, big, small)
+}
+
+// In passes if element is an element of slice. With a Check handle, it calls
+// [check.In] and returns true if it passed. With an Assert handle, it calls
+// [assert.In], which stops the test if it fails.
+func In[Type any](h Handle, element Type, slice []Type, opts ...gocmp.Option) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.In(t, element, slice, opts...)
+		return true
+	}
+	return check.In(t, element, slice, opts...)
+}
+
+// NotIn passes if element is not an element of slice. With a Check handle, it
+// calls [check.NotIn] and returns true if it passed. With an Assert handle, it
+// calls [assert.NotIn], which stops the test if it fails.
+func NotIn[Type any](h Handle, element Type, slice []Type, opts ...gocmp.Option) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.NotIn(t, element, slice, opts...)
+		return true
+	}
+	return check.NotIn(t, element, slice, opts...)
+}
+
+// ElementsMatch passes if want and got contain the same elements in any order.
+// With a Check handle, it calls [check.ElementsMatch] and returns true if it
+// passed. With an Assert handle, it calls [assert.ElementsMatch], which stops
+// the test if it fails.
+func ElementsMatch[Type any](h Handle, want []Type, got []Type, opts ...gocmp.Option) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.ElementsMatch(t, want, got, opts...)
+		return true
+	}
+	return check.ElementsMatch(t, want, got, opts...)
+}
+
+// Subset passes if every element of got is an element of want. With a Check
+// handle, it calls [check.Subset] and returns true if it passed. With an Assert
+// handle, it calls [assert.Subset], which stops the test if it fails.
+func Subset[Type any](h Handle, want []Type, got []Type, opts ...gocmp.Option) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.Subset(t, want, got, opts...)
+		return true
+	}
+	return check.Subset(t, want, got, opts...)
+}
+
+// Superset passes if every element of want is an element of got. With a Check
+// handle, it calls [check.Superset] and returns true if it passed. With an
+// Assert handle, it calls [assert.Superset], which stops the test if it fails.
+func Superset[Type any](h Handle, want []Type, got []Type, opts ...gocmp.Option) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.Superset(t, want, got, opts...)
+		return true
+	}
+	return check.Superset(t, want, got, opts...)
+}
+
+// MapContains passes if every entry of want is in got. With a Check handle, it
+// calls [check.MapContains] and returns true if it passed. With an Assert
+// handle, it calls [assert.MapContains], which stops the test if it fails.
+func MapContains[Key comparable, Value any](h Handle, want map[Key]Value, got map[Key]Value, opts ...gocmp.Option) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.MapContains(t, want, got, opts...)
+		return true
+	}
+	return check.MapContains(t, want, got, opts...)
+}
+
+// Zero passes if val is the zero value of its type. With a Check handle, it
+// calls [check.Zero] and returns true if it passed. With an Assert handle, it
+// calls [assert.Zero], which stops the test if it fails.
+func Zero[Type any](h Handle, val Type) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.Zero(t, val)
+		return true
+	}
+	return check.Zero(t, val)
+}
+
+// NotZero passes if val is not the zero value of its type. With a Check handle,
+// it calls [check.NotZero] and returns true if it passed. With an Assert
+// handle, it calls [assert.NotZero], which stops the test if it fails.
+func NotZero[Type any](h Handle, val Type) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.NotZero(t, val)
+		return true
+	}
+	return check.NotZero(t, val)
+}
+
+// ErrorAs passes and returns the first error in err's tree that matches the
+// type E, and true, if errors.As(err, &target). It calls [check.ErrorAs] or
+// [assert.ErrorAs], depending on h.
+func ErrorAs[E error](h Handle, err error) (E, bool) {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		return assert.ErrorAs[E](t, err), true
+	}
+	return check.ErrorAs[E](t, err)
+}
+
+// InDelta passes if want and got are at most delta apart. With a Check handle,
+// it calls [check.InDelta] and returns true if it passed. With an Assert
+// handle, it calls [assert.InDelta], which stops the test if it fails.
+func InDelta[F check.Float](h Handle, want F, got F, delta F) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.InDelta(t, want, got, delta)
+		return true
+	}
+	return check.InDelta(t, want, got, delta)
+}
+
+// InDeltaSlice passes if each pair of elements is at most delta apart. With a
+// Check handle, it calls [check.InDeltaSlice] and returns true if it passed.
+// With an Assert handle, it calls [assert.InDeltaSlice], which stops the test
+// if it fails.
+func InDeltaSlice[F check.Float](h Handle, want []F, got []F, delta F) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.InDeltaSlice(t, want, got, delta)
+		return true
+	}
+	return check.InDeltaSlice(t, want, got, delta)
+}
+
+// InDeltaMap passes if each pair of values is at most delta apart. With a Check
+// handle, it calls [check.InDeltaMap] and returns true if it passed. With an
+// Assert handle, it calls [assert.InDeltaMap], which stops the test if it
+// fails.
+func InDeltaMap[Key comparable, F check.Float](h Handle, want map[Key]F, got map[Key]F, delta F) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.InDeltaMap(t, want, got, delta)
+		return true
+	}
+	return check.InDeltaMap(t, want, got, delta)
+}
+
+// InEpsilon passes if the relative error between want and got is at most
+// epsilon. With a Check handle, it calls [check.InEpsilon] and returns true if
+// it passed. With an Assert handle, it calls [assert.InEpsilon], which stops
+// the test if it fails.
+func InEpsilon[F check.Float](h Handle, want F, got F, epsilon F) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.InEpsilon(t, want, got, epsilon)
+		return true
+	}
+	return check.InEpsilon(t, want, got, epsilon)
+}
+
+// InEpsilonSlice passes if the relative error of each pair of elements is at
+// most epsilon. With a Check handle, it calls [check.InEpsilonSlice] and
+// returns true if it passed. With an Assert handle, it calls
+// [assert.InEpsilonSlice], which stops the test if it fails.
+func InEpsilonSlice[F check.Float](h Handle, want []F, got []F, epsilon F) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.InEpsilonSlice(t, want, got, epsilon)
+		return true
+	}
+	return check.InEpsilonSlice(t, want, got, epsilon)
+}
+
+// InEpsilonMap passes if the relative error of each pair of values is at most
+// epsilon. With a Check handle, it calls [check.InEpsilonMap] and returns true
+// if it passed. With an Assert handle, it calls [assert.InEpsilonMap], which
+// stops the test if it fails.
+func InEpsilonMap[Key comparable, F check.Float](h Handle, want map[Key]F, got map[Key]F, epsilon F) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.InEpsilonMap(t, want, got, epsilon)
+		return true
+	}
+	return check.InEpsilonMap(t, want, got, epsilon)
+}
+
+// WithinULP passes if want and got are at most ulps floats apart. With a Check
+// handle, it calls [check.WithinULP] and returns true if it passed. With an
+// Assert handle, it calls [assert.WithinULP], which stops the test if it fails.
+func WithinULP[F check.Float](h Handle, want F, got F, ulps uint64) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.WithinULP(t, want, got, ulps)
+		return true
+	}
+	return check.WithinULP(t, want, got, ulps)
+}
+
+// WithinULPSlice passes if each pair of elements is at most ulps floats apart.
+// With a Check handle, it calls [check.WithinULPSlice] and returns true if it
+// passed. With an Assert handle, it calls [assert.WithinULPSlice], which stops
+// the test if it fails.
+func WithinULPSlice[F check.Float](h Handle, want []F, got []F, ulps uint64) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.WithinULPSlice(t, want, got, ulps)
+		return true
+	}
+	return check.WithinULPSlice(t, want, got, ulps)
+}
+
+// WithinULPMap passes if each pair of values is at most ulps floats apart. With
+// a Check handle, it calls [check.WithinULPMap] and returns true if it passed.
+// With an Assert handle, it calls [assert.WithinULPMap], which stops the test
+// if it fails.
+func WithinULPMap[Key comparable, F check.Float](h Handle, want map[Key]F, got map[Key]F, ulps uint64) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.WithinULPMap(t, want, got, ulps)
+		return true
+	}
+	return check.WithinULPMap(t, want, got, ulps)
+}
+
+// Before passes if small.Compare(big) < 0. With a Check handle, it calls
+// [check.Before] and returns true if it passed. With an Assert handle, it calls
+// [assert.Before], which stops the test if it fails.
+func Before[Type check.Comparer[Type]](h Handle, small Type, big Type) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.Before(t, small, big)
+		return true
+	}
+	return check.Before(t, small, big)
+}
+
+// After passes if big.Compare(small) > 0. With a Check handle, it calls
+// [check.After] and returns true if it passed. With an Assert handle, it calls
+// [assert.After], which stops the test if it fails.
+func After[Type check.Comparer[Type]](h Handle, big Type, small Type) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.After(t, big, small)
+		return true
+	}
+	return check.After(t, big, small)
+}
+
+// InRangeCompare passes if lo <= got <= hi, using their Compare method. With a
+// Check handle, it calls [check.InRangeCompare] and returns true if it passed.
+// With an Assert handle, it calls [assert.InRangeCompare], which stops the test
+// if it fails.
+func InRangeCompare[Type check.Comparer[Type]](h Handle, lo Type, hi Type, got Type) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.InRangeCompare(t, lo, hi, got)
+		return true
+	}
+	return check.InRangeCompare(t, lo, hi, got)
+}
+
+// InRange passes if lo <= got <= hi. With a Check handle, it calls
+// [check.InRange] and returns true if it passed. With an Assert handle, it
+// calls [assert.InRange], which stops the test if it fails.
+func InRange[Type cmp.Ordered](h Handle, lo Type, hi Type, got Type) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.InRange(t, lo, hi, got)
+		return true
+	}
+	return check.InRange(t, lo, hi, got)
+}
+
+// InRangeFunc passes if lo <= got <= hi, according to compare. With a Check
+// handle, it calls [check.InRangeFunc] and returns true if it passed. With an
+// Assert handle, it calls [assert.InRangeFunc], which stops the test if it
+// fails.
+func InRangeFunc[Type any](h Handle, lo Type, hi Type, got Type, compare func(a, b Type) int) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.InRangeFunc(t, lo, hi, got, compare)
+		return true
+	}
+	return check.InRangeFunc(t, lo, hi, got, compare)
+}
+
+// LessThanFunc passes if small < big, according to compare. With a Check
+// handle, it calls [check.LessThanFunc] and returns true if it passed. With an
+// Assert handle, it calls [assert.LessThanFunc], which stops the test if it
+// fails.
+func LessThanFunc[Type any](h Handle, small Type, big Type, compare func(a, b Type) int) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.LessThanFunc(t, small, big, compare)
+		return true
+	}
+	return check.LessThanFunc(t, small, big, compare)
+}
+
+// LessThanOrEqualFunc passes if small <= big, according to compare. With a
+// Check handle, it calls [check.LessThanOrEqualFunc] and returns true if it
+// passed. With an Assert handle, it calls [assert.LessThanOrEqualFunc], which
+// stops the test if it fails.
+func LessThanOrEqualFunc[Type any](h Handle, small Type, big Type, compare func(a, b Type) int) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.LessThanOrEqualFunc(t, small, big, compare)
+		return true
+	}
+	return check.LessThanOrEqualFunc(t, small, big, compare)
+}
+
+// GreaterThanFunc passes if big > small, according to compare. With a Check
+// handle, it calls [check.GreaterThanFunc] and returns true if it passed. With
+// an Assert handle, it calls [assert.GreaterThanFunc], which stops the test if
+// it fails.
+func GreaterThanFunc[Type any](h Handle, big Type, small Type, compare func(a, b Type) int) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.GreaterThanFunc(t, big, small, compare)
+		return true
+	}
+	return check.GreaterThanFunc(t, big, small, compare)
+}
+
+// GreaterThanOrEqualFunc passes if big >= small, according to compare. With a
+// Check handle, it calls [check.GreaterThanOrEqualFunc] and returns true if it
+// passed. With an Assert handle, it calls [assert.GreaterThanOrEqualFunc],
+// which stops the test if it fails.
+func GreaterThanOrEqualFunc[Type any](h Handle, big Type, small Type, compare func(a, b Type) int) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.GreaterThanOrEqualFunc(t, big, small, compare)
+		return true
+	}
+	return check.GreaterThanOrEqualFunc(t, big, small, compare)
+}
+
+// PanicsWith passes if fn panics with a value equal to want. With a Check
+// handle, it calls [check.PanicsWith] and returns true if it passed. With an
+// Assert handle, it calls [assert.PanicsWith], which stops the test if it
+// fails.
+func PanicsWith[Type any](h Handle, want Type, fn func(), opts ...gocmp.Option) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.PanicsWith(t, want, fn, opts...)
+		return true
+	}
+	return check.PanicsWith(t, want, fn, opts...)
+}
+
+// Contains passes if s contains substring. With a Check handle, it calls
+// [check.Contains] and returns true if it passed. With an Assert handle, it
+// calls [assert.Contains], which stops the test if it fails.
+func Contains[Sub check.Text, S check.Text](h Handle, substring Sub, s S) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.Contains(t, substring, s)
+		return true
+	}
+	return check.Contains(t, substring, s)
+}
+
+// NotContains passes if s does not contain substring. With a Check handle, it
+// calls [check.NotContains] and returns true if it passed. With an Assert
+// handle, it calls [assert.NotContains], which stops the test if it fails.
+func NotContains[Sub check.Text, S check.Text](h Handle, substring Sub, s S) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.NotContains(t, substring, s)
+		return true
+	}
+	return check.NotContains(t, substring, s)
+}
+
+// HasPrefix passes if s begins with prefix. With a Check handle, it calls
+// [check.HasPrefix] and returns true if it passed. With an Assert handle, it
+// calls [assert.HasPrefix], which stops the test if it fails.
+func HasPrefix[Prefix check.Text, S check.Text](h Handle, prefix Prefix, s S) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.HasPrefix(t, prefix, s)
+		return true
+	}
+	return check.HasPrefix(t, prefix, s)
+}
+
+// HasSuffix passes if s ends with suffix. With a Check handle, it calls
+// [check.HasSuffix] and returns true if it passed. With an Assert handle, it
+// calls [assert.HasSuffix], which stops the test if it fails.
+func HasSuffix[Suffix check.Text, S check.Text](h Handle, suffix Suffix, s S) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.HasSuffix(t, suffix, s)
+		return true
+	}
+	return check.HasSuffix(t, suffix, s)
+}
+
+// Matches passes if s contains a match of the regular expression pattern. With
+// a Check handle, it calls [check.Matches] and returns true if it passed. With
+// an Assert handle, it calls [assert.Matches], which stops the test if it
+// fails.
+func Matches[S check.Text](h Handle, pattern string, s S) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.Matches(t, pattern, s)
+		return true
+	}
+	return check.Matches(t, pattern, s)
+}
+
+// That passes if m matches got. With a Check handle, it calls [check.That] and
+// returns true if it passed. With an Assert handle, it calls [assert.That],
+// which stops the test if it fails.
+func That[T any](h Handle, got T, m match.Matcher[T]) bool {
+	t := h.T()
+	t.Helper()
+	if h.Fatal() {
+		assert.That(t, got, m)
+		return true
+	}
+	return check.That(t, got, m)
+}
+
+// Define turns a condition into a check that works with either handle, like
+// [check.Define]. With a Check handle, the returned function reports a failure
+// and returns false if got does not pass. With an Assert handle, it also stops
+// the test, like [assert.Must].
+func Define[T any](fn func(got T) (ok bool, msg string)) func(h Handle, got T) bool {
+	soft := check.Define(fn)
+	hard := assert.Must(soft)
+	return func(h Handle, got T) bool {
+		t := h.T()
+		t.Helper()
+		if h.Fatal() {
+			hard(t, got)
+			return true
+		}
+		return soft(t, got)
+	}
+}
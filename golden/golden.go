@@ -0,0 +1,214 @@
+// Package golden compares test output against golden files stored in the
+// testdata directory of the package being tested.
+//
+// Golden files are stored at testdata/<TestName>/<name>.golden. To create or
+// update them, run the tests with the -testy.update flag or with
+// TESTY_UPDATE=1 in the environment. Packages that don't import golden don't
+// define the -testy.update flag, so use the environment variable when testing
+// multiple packages:
+//
+//	go test ./mypackage -testy.update
+//	TESTY_UPDATE=1 go test ./...
+//
+// If your tests already define their own -update flag, passing it updates
+// golden files too.
+package golden
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/internal/diff"
+	"github.com/peterldowns/testy/report"
+)
+
+// UpdateEnv is the environment variable that, when set to "1", causes golden
+// files to be rewritten instead of compared against.
+const UpdateEnv = "TESTY_UPDATE"
+
+// update is namespaced so that it can't collide with an -update flag defined
+// by the tests themselves, which Updating also respects.
+var update = flag.Bool("testy.update", false, "update golden files instead of comparing against them")
+
+// Equal passes and returns true if got is equal to the contents of the golden
+// file testdata/<TestName>/<name>.golden. If updating is enabled, the golden
+// file is written with the contents of got instead.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// t must have a Name() method, like *testing.T.
+func Equal(t common.T, name string, got []byte) bool {
+	t.Helper()
+	path, ok := pathFor(t, name)
+	if !ok {
+		return false
+	}
+	if Updating() {
+		return write(t, path, got)
+	}
+	want, ok := read(t, path)
+	if !ok {
+		return false
+	}
+	if string(want) == string(got) {
+		return true
+	}
+	d := diff.Text(string(want), string(got))
+	report.Report(t, report.Failure{
+		Message: fmt.Sprintf("expected golden file == got\nfile: %s\n%s", path, d),
+		Want:    string(want),
+		Got:     string(got),
+		Diff:    d,
+	})
+	return false
+}
+
+// EqualValue passes and returns true if got is equal to the value stored as
+// JSON in the golden file testdata/<TestName>/<name>.golden. If updating is
+// enabled, the golden file is written with got encoded as indented JSON
+// instead.
+//
+// Otherwise, the test is marked as failed with t.Error(), this function returns
+// false, and the test continues running.
+//
+// The stored value is decoded into a Type and compared with got using go-cmp,
+// so only the fields of Type that survive a round-trip through encoding/json
+// are compared. You can change the behavior of the equality checking using the
+// go-cmp/cmp Options system. For more information, see [the go-cmp documentation](https://pkg.go.dev/github.com/google/go-cmp/cmp#Equal).
+//
+// If Type is a string or byte slice type, the failure message shows a text
+// diff, like check.Equal does.
+//
+// t must have a Name() method, like *testing.T.
+func EqualValue[Type any](t common.T, name string, got Type, opts ...gocmp.Option) bool {
+	t.Helper()
+	path, ok := pathFor(t, name)
+	if !ok {
+		return false
+	}
+	if Updating() {
+		data, err := json.MarshalIndent(got, "", "  ")
+		if err != nil {
+			report.Report(t, report.Failure{Message: fmt.Sprintf("failed to encode value for golden file\nfile: %s\n err: %s", path, err)})
+			return false
+		}
+		return write(t, path, append(data, '\n'))
+	}
+	data, ok := read(t, path)
+	if !ok {
+		return false
+	}
+	var want Type
+	if err := json.Unmarshal(data, &want); err != nil {
+		report.Report(t, report.Failure{Message: fmt.Sprintf("failed to decode golden file\nfile: %s\n err: %s", path, err)})
+		return false
+	}
+	d := gocmp.Diff(want, got, opts...)
+	if d == "" {
+		return true
+	}
+	message := fmt.Sprintf("expected golden value == got\nfile: %s\n--- want\n+++ got\n%s", path, d)
+	if wantText, gotText, ok := diff.AsText(want, got); ok && wantText != gotText {
+		d = diff.Text(wantText, gotText)
+		message = fmt.Sprintf("expected golden value == got\nfile: %s\n%s", path, d)
+	}
+	report.Report(t, report.Failure{
+		Message: message,
+		Want:    want,
+		Got:     got,
+		Diff:    d,
+		Options: opts,
+	})
+	return false
+}
+
+// AssertEqual passes if got is equal to the contents of the golden file
+// testdata/<TestName>/<name>.golden. If updating is enabled, the golden file is
+// written with the contents of got instead.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// t must have a Name() method, like *testing.T.
+func AssertEqual(t common.T, name string, got []byte) {
+	t.Helper()
+	if !Equal(t, name, got) {
+		t.FailNow()
+	}
+}
+
+// AssertEqualValue passes if got is equal to the value stored as JSON in the
+// golden file testdata/<TestName>/<name>.golden. If updating is enabled, the
+// golden file is written with got encoded as indented JSON instead.
+//
+// Otherwise, the test is immediately failed and stopped with t.FailNow().
+//
+// t must have a Name() method, like *testing.T.
+func AssertEqualValue[Type any](t common.T, name string, got Type, opts ...gocmp.Option) {
+	t.Helper()
+	if !EqualValue(t, name, got, opts...) {
+		t.FailNow()
+	}
+}
+
+// Updating returns true if golden files should be rewritten instead of
+// compared against, because either the -testy.update flag was passed,
+// TESTY_UPDATE=1 is set, or the tests define their own -update flag and it
+// was passed.
+func Updating() bool {
+	if *update || os.Getenv(UpdateEnv) == "1" {
+		return true
+	}
+	f := flag.Lookup("update")
+	return f != nil && f.Value.String() == "true"
+}
+
+// pathFor returns the path of the golden file with the given name for the
+// current test.
+func pathFor(t common.T, name string) (string, bool) {
+	t.Helper()
+	testName := common.AsTB(report.Unwrap(t)).Name()
+	if testName == "" {
+		report.Report(t, report.Failure{Message: fmt.Sprintf("golden files require a T with a Name() method, received %T", t)})
+		return "", false
+	}
+	return filepath.Join("testdata", filepath.FromSlash(testName), name+".golden"), true
+}
+
+// read returns the contents of the golden file at path.
+func read(t common.T, path string) ([]byte, bool) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		report.Report(t, report.Failure{Message: fmt.Sprintf("golden file does not exist, run with -testy.update or %s=1 to create it\nfile: %s", UpdateEnv, path)})
+		return nil, false
+	}
+	if err != nil {
+		report.Report(t, report.Failure{Message: fmt.Sprintf("failed to read golden file\nfile: %s\n err: %s", path, err)})
+		return nil, false
+	}
+	return data, true
+}
+
+// write creates or replaces the golden file at path.
+func write(t common.T, path string, data []byte) bool {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		report.Report(t, report.Failure{Message: fmt.Sprintf("failed to create golden file directory\nfile: %s\n err: %s", path, err)})
+		return false
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		report.Report(t, report.Failure{Message: fmt.Sprintf("failed to write golden file\nfile: %s\n err: %s", path, err)})
+		return false
+	}
+	common.AsTB(report.Unwrap(t)).Logf("updated golden file %s", path)
+	return true
+}
@@ -0,0 +1,146 @@
+package golden_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/golden"
+)
+
+// skipIfUpdating skips tests that intentionally compare against the wrong
+// values, which would overwrite the golden files when run with -testy.update.
+func skipIfUpdating(t *testing.T) {
+	t.Helper()
+	if golden.Updating() {
+		t.Skip("skipping while updating golden files")
+	}
+}
+
+type person struct {
+	Name string
+	Age  int
+}
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+	skipIfUpdating(t)
+	check.True(t, golden.Equal(t, "greeting", []byte("hello\nworld\n")))
+	// Annotated tests use the name of the test they annotate.
+	check.True(t, golden.Equal(check.With(t, "annotated"), "greeting", []byte("hello\nworld\n")))
+
+	mt := &common.MockT{TestName: t.Name()}
+	check.False(t, golden.Equal(mt, "greeting", []byte("hello\nthere\n")))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
+
+func TestEqualValue(t *testing.T) {
+	t.Parallel()
+	skipIfUpdating(t)
+	check.True(t, golden.EqualValue(t, "person", person{Name: "peter", Age: 29}))
+
+	mt := &common.MockT{TestName: t.Name()}
+	check.False(t, golden.EqualValue(mt, "person", person{Name: "peter", Age: 30}))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+
+	for _, m := range mt.Messages() {
+		check.NotContains(t, `\n`, m.Text)
+	}
+
+	// Strings are shown with a text diff.
+	check.True(t, golden.EqualValue(t, "message", "hello\nworld\n"))
+	mt = &common.MockT{TestName: t.Name()}
+	check.False(t, golden.EqualValue(mt, "message", "hello\nthere\n"))
+	if messages := mt.Messages(); check.Equal(t, 1, len(messages)) {
+		check.Contains(t, "\n hello\n-world\n+there", messages[0].Text)
+	}
+
+	// The golden file is not valid JSON for a []int.
+	mt = &common.MockT{TestName: t.Name()}
+	check.False(t, golden.EqualValue(mt, "person", []int{1, 2, 3}))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
+
+func TestAssertEqual(t *testing.T) {
+	t.Parallel()
+	skipIfUpdating(t)
+	golden.AssertEqual(t, "greeting", []byte("hello\nworld\n"))
+
+	mt := &common.MockT{TestName: t.Name()}
+	golden.AssertEqual(mt, "greeting", []byte("goodbye\n"))
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestAssertEqualValue(t *testing.T) {
+	t.Parallel()
+	skipIfUpdating(t)
+	golden.AssertEqualValue(t, "person", person{Name: "peter", Age: 29})
+
+	mt := &common.MockT{TestName: t.Name()}
+	golden.AssertEqualValue(mt, "person", person{Name: "bob", Age: 29})
+	check.True(t, mt.Failed())
+	check.True(t, mt.FailedNow())
+}
+
+func TestMissingGoldenFile(t *testing.T) {
+	t.Parallel()
+	skipIfUpdating(t)
+	mt := &common.MockT{TestName: t.Name()}
+	check.False(t, golden.Equal(mt, "missing", []byte("hello")))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
+
+func TestRequiresName(t *testing.T) {
+	t.Parallel()
+	skipIfUpdating(t)
+	mt := &common.MockT{}
+	check.False(t, golden.Equal(mt, "greeting", []byte("hello\nworld\n")))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+}
+
+func TestUpdate(t *testing.T) { //nolint:paralleltest // modifies the environment
+	skipIfUpdating(t)
+	dir := filepath.Join("testdata", t.Name())
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	t.Setenv(golden.UpdateEnv, "1")
+	check.True(t, golden.Updating())
+	check.True(t, golden.Equal(t, "greeting", []byte("hello\n")))
+	check.True(t, golden.EqualValue(t, "person", person{Name: "peter", Age: 29}))
+
+	data, err := os.ReadFile(filepath.Join(dir, "greeting.golden"))
+	check.NoError(t, err)
+	check.Equal(t, "hello\n", string(data))
+
+	t.Setenv(golden.UpdateEnv, "")
+	check.False(t, golden.Updating())
+	check.True(t, golden.Equal(t, "greeting", []byte("hello\n")))
+	check.True(t, golden.EqualValue(t, "person", person{Name: "peter", Age: 29}))
+}
+
+// update is a flag defined by the tests themselves, like many packages with
+// their own golden file helpers do. Defining it must not conflict with golden.
+var update = flag.Bool("update", false, "update golden files")
+
+func TestUpdateFlags(t *testing.T) { //nolint:paralleltest // modifies global flags
+	skipIfUpdating(t)
+	t.Setenv(golden.UpdateEnv, "")
+	for _, name := range []string{"update", "testy.update"} {
+		check.False(t, golden.Updating())
+		check.NoError(t, flag.Set(name, "true"))
+		check.True(t, golden.Updating())
+		check.NoError(t, flag.Set(name, "false"))
+	}
+	check.False(t, *update)
+}
@@ -0,0 +1,297 @@
+// Package diff formats human-readable differences between strings for use in
+// failure messages.
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// context is the number of unchanged lines shown around each change in a
+// unified diff.
+const context = 3
+
+// maxEditDistance bounds the work done to find the smallest diff between two
+// texts. Texts that differ by more lines than this get a larger, but still
+// correct, diff.
+const maxEditDistance = 1000
+
+// Text returns a human-readable description of the differences between want
+// and got. If either is multi-line, it is a line-oriented unified diff. If both
+// are single lines, it shows both quoted strings with a caret under the first
+// differing rune.
+func Text(want, got string) string {
+	if strings.Contains(want, "\n") || strings.Contains(got, "\n") {
+		return Unified(want, got)
+	}
+	return Caret(want, got)
+}
+
+// AsText returns want and got as strings if they are both strings or both
+// byte slices of the same type, so that they can be shown with Text. Values
+// of any other types are best shown with a go-cmp diff instead.
+func AsText(want, got any) (string, string, bool) {
+	wantValue, gotValue := reflect.ValueOf(want), reflect.ValueOf(got)
+	if !wantValue.IsValid() || !gotValue.IsValid() || wantValue.Type() != gotValue.Type() {
+		return "", "", false
+	}
+	switch {
+	case wantValue.Kind() == reflect.String:
+		return wantValue.String(), gotValue.String(), true
+	case wantValue.Kind() == reflect.Slice && wantValue.Type().Elem().Kind() == reflect.Uint8:
+		return string(wantValue.Bytes()), string(gotValue.Bytes()), true
+	default:
+		return "", "", false
+	}
+}
+
+// Caret shows want and got as quoted strings, one above the other, with a
+// caret under the first rune at which they differ:
+//
+//	want: "hello world"
+//	 got: "hello wurld"
+//	             ^
+func Caret(want, got string) string {
+	prefix := 0
+	for prefix < len(want) && prefix < len(got) {
+		wr, wsize := utf8.DecodeRuneInString(want[prefix:])
+		gr, gsize := utf8.DecodeRuneInString(got[prefix:])
+		if wr != gr || wsize != gsize {
+			break
+		}
+		prefix += wsize
+	}
+	quoted := strconv.Quote(want[:prefix])
+	column := len(`want: "`) + utf8.RuneCountInString(quoted[1:len(quoted)-1])
+	return fmt.Sprintf("want: %q\n got: %q\n%s^", want, got, strings.Repeat(" ", column))
+}
+
+// Unified returns a line-oriented unified diff from want to got, with @@ hunk
+// headers and unchanged context lines around each change. It returns "" if
+// want and got are equal.
+func Unified(want, got string) string {
+	if want == got {
+		return ""
+	}
+	edits := diffLines(splitLines(want), splitLines(got))
+	var b strings.Builder
+	b.WriteString("--- want\n+++ got\n")
+	for _, h := range hunks(edits) {
+		writeHunk(&b, edits[h.start:h.end])
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+type operation int
+
+const (
+	equal operation = iota
+	deleted
+	inserted
+)
+
+// edit is a single line of a diff.
+type edit struct {
+	op   operation
+	line string
+	// wantLine and gotLine are the 0-based indexes of the line in want and
+	// got, or of the position that the line would be at if it is missing.
+	wantLine int
+	gotLine  int
+}
+
+// splitLines splits s into lines, each of which keeps its trailing newline so
+// that a missing newline at the end of the text is reported as a difference.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines returns the edits that turn want into got, using the Myers
+// algorithm on the lines between the common prefix and suffix.
+func diffLines(want, got []string) []edit {
+	prefix := 0
+	for prefix < len(want) && prefix < len(got) && want[prefix] == got[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(want)-prefix && suffix < len(got)-prefix &&
+		want[len(want)-1-suffix] == got[len(got)-1-suffix] {
+		suffix++
+	}
+	edits := make([]edit, 0, len(want)+len(got))
+	for i := 0; i < prefix; i++ {
+		edits = append(edits, edit{op: equal, line: want[i], wantLine: i, gotLine: i})
+	}
+	middle := myers(want[prefix:len(want)-suffix], got[prefix:len(got)-suffix])
+	for _, e := range middle {
+		e.wantLine += prefix
+		e.gotLine += prefix
+		edits = append(edits, e)
+	}
+	for i := suffix; i > 0; i-- {
+		edits = append(edits, edit{op: equal, line: want[len(want)-i], wantLine: len(want) - i, gotLine: len(got) - i})
+	}
+	return edits
+}
+
+// myers returns the shortest list of edits that turns a into b. If a and b
+// differ by more than maxEditDistance lines, it gives up and returns a diff
+// that deletes all of a and inserts all of b.
+func myers(a, b []string) []edit {
+	n, m := len(a), len(b)
+	limit := n + m
+	if limit > maxEditDistance {
+		limit = maxEditDistance
+	}
+	offset := limit + 1
+	v := make([]int, 2*limit+3)
+	var trace [][]int
+	for d := 0; d <= limit; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(a, b, trace, offset)
+			}
+		}
+	}
+	edits := make([]edit, 0, n+m)
+	for i, line := range a {
+		edits = append(edits, edit{op: deleted, line: line, wantLine: i, gotLine: 0})
+	}
+	for i, line := range b {
+		edits = append(edits, edit{op: inserted, line: line, wantLine: n, gotLine: i})
+	}
+	return edits
+}
+
+// backtrack walks the trace of the Myers algorithm backwards from the end of
+// both texts to recover the edits.
+func backtrack(a, b []string, trace [][]int, offset int) []edit {
+	x, y := len(a), len(b)
+	var reversed []edit
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+			reversed = append(reversed, edit{op: equal, line: a[x], wantLine: x, gotLine: y})
+		}
+		if x == prevX {
+			y--
+			reversed = append(reversed, edit{op: inserted, line: b[y], wantLine: x, gotLine: y})
+		} else {
+			x--
+			reversed = append(reversed, edit{op: deleted, line: a[x], wantLine: x, gotLine: y})
+		}
+	}
+	for x > 0 && y > 0 {
+		x--
+		y--
+		reversed = append(reversed, edit{op: equal, line: a[x], wantLine: x, gotLine: y})
+	}
+	edits := make([]edit, len(reversed))
+	for i, e := range reversed {
+		edits[len(reversed)-1-i] = e
+	}
+	return edits
+}
+
+// hunk is a range of edits that are shown together.
+type hunk struct {
+	start, end int
+}
+
+// hunks groups the changed edits, along with up to context unchanged edits on
+// either side. Changes that are close together share a hunk.
+func hunks(edits []edit) []hunk {
+	var result []hunk
+	for i, e := range edits {
+		if e.op == equal {
+			continue
+		}
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+		end := i + 1 + context
+		if end > len(edits) {
+			end = len(edits)
+		}
+		if len(result) > 0 && start <= result[len(result)-1].end {
+			result[len(result)-1].end = end
+			continue
+		}
+		result = append(result, hunk{start: start, end: end})
+	}
+	return result
+}
+
+// writeHunk writes a single hunk, including its @@ header, to b.
+func writeHunk(b *strings.Builder, edits []edit) {
+	wantStart, gotStart := edits[0].wantLine, edits[0].gotLine
+	wantCount, gotCount := 0, 0
+	for _, e := range edits {
+		if e.op != inserted {
+			wantCount++
+		}
+		if e.op != deleted {
+			gotCount++
+		}
+	}
+	fmt.Fprintf(b, "@@ -%s +%s @@\n", hunkRange(wantStart, wantCount), hunkRange(gotStart, gotCount))
+	for _, e := range edits {
+		switch e.op {
+		case equal:
+			b.WriteString(" ")
+		case deleted:
+			b.WriteString("-")
+		case inserted:
+			b.WriteString("+")
+		}
+		b.WriteString(strings.TrimSuffix(e.line, "\n"))
+		b.WriteString("\n")
+		if !strings.HasSuffix(e.line, "\n") {
+			b.WriteString("\\ No newline at end of file\n")
+		}
+	}
+}
+
+// hunkRange formats the line range of a hunk like GNU diff: 1-based, and
+// pointing at the preceding line if the range is empty.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return strconv.Itoa(start + 1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
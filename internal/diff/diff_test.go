@@ -0,0 +1,133 @@
+package diff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/internal/diff"
+)
+
+func TestText(t *testing.T) {
+	t.Parallel()
+	check.Equal(t, diff.Caret("a", "b"), diff.Text("a", "b"))
+	check.Equal(t, diff.Unified("a\n", "b\n"), diff.Text("a\n", "b\n"))
+	check.Equal(t, diff.Unified("a", "a\nb"), diff.Text("a", "a\nb"))
+}
+
+func TestAsText(t *testing.T) {
+	t.Parallel()
+	type myString string
+	want, got, ok := diff.AsText("a", "b")
+	check.True(t, ok)
+	check.Equal(t, "a", want)
+	check.Equal(t, "b", got)
+	want, got, ok = diff.AsText([]byte("a"), []byte("b"))
+	check.True(t, ok)
+	check.Equal(t, "a", want)
+	check.Equal(t, "b", got)
+	_, _, ok = diff.AsText(myString("a"), myString("b"))
+	check.True(t, ok)
+
+	_, _, ok = diff.AsText(1, 2)
+	check.False(t, ok)
+	_, _, ok = diff.AsText("a", []byte("b"))
+	check.False(t, ok)
+	_, _, ok = diff.AsText("a", myString("b"))
+	check.False(t, ok)
+	_, _, ok = diff.AsText(nil, "b")
+	check.False(t, ok)
+}
+
+func TestCaret(t *testing.T) {
+	t.Parallel()
+	check.Equal(t, strings.Join([]string{
+		`want: "hello world"`,
+		` got: "hello wurld"`,
+		`              ^`,
+	}, "\n"), diff.Caret("hello world", "hello wurld"))
+
+	// The caret is placed by rune, accounting for escaped characters.
+	check.Equal(t, strings.Join([]string{
+		`want: "héllo\tworld"`,
+		` got: "héllo\twurld"`,
+		`               ^`,
+	}, "\n"), diff.Caret("héllo\tworld", "héllo\twurld"))
+
+	// If one string is a prefix of the other, the caret is placed after it.
+	check.Equal(t, strings.Join([]string{
+		`want: "abc"`,
+		` got: "ab"`,
+		`         ^`,
+	}, "\n"), diff.Caret("abc", "ab"))
+}
+
+func TestUnified(t *testing.T) {
+	t.Parallel()
+	check.Equal(t, "", diff.Unified("same\n", "same\n"))
+
+	check.Equal(t, strings.Join([]string{
+		"--- want",
+		"+++ got",
+		"@@ -1,5 +1,5 @@",
+		" a",
+		"-b",
+		"+B",
+		" c",
+		" d",
+		" e",
+		"@@ -9,3 +9,4 @@",
+		" i",
+		" j",
+		" k",
+		"+l",
+		`\ No newline at end of file`,
+	}, "\n"), diff.Unified(
+		"a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\n",
+		"a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl",
+	))
+
+	// Changes that are close together share a hunk.
+	check.Equal(t, strings.Join([]string{
+		"--- want",
+		"+++ got",
+		"@@ -1,6 +1,5 @@",
+		" a",
+		"-b",
+		" c",
+		" d",
+		"-e",
+		"+E",
+		" f",
+	}, "\n"), diff.Unified("a\nb\nc\nd\ne\nf\n", "a\nc\nd\nE\nf\n"))
+
+	// Empty ranges point at the preceding line.
+	check.Equal(t, strings.Join([]string{
+		"--- want",
+		"+++ got",
+		"@@ -0,0 +1 @@",
+		"+x",
+	}, "\n"), diff.Unified("", "x\n"))
+	check.Equal(t, strings.Join([]string{
+		"--- want",
+		"+++ got",
+		"@@ -1,2 +0,0 @@",
+		"-x",
+		"-y",
+	}, "\n"), diff.Unified("x\ny\n", ""))
+}
+
+func TestUnifiedLarge(t *testing.T) {
+	t.Parallel()
+	// Texts that differ by more lines than the edit distance limit still get
+	// a correct diff.
+	var want, got strings.Builder
+	for i := 0; i < 2000; i++ {
+		want.WriteString("want\n")
+		got.WriteString("got\n")
+	}
+	result := diff.Unified(want.String(), got.String())
+	check.Equal(t, 2000, strings.Count(result, "\n-want"))
+	check.Equal(t, 2000, strings.Count(result, "\n+got"))
+	check.True(t, strings.HasPrefix(result, "--- want\n+++ got\n@@ -1,2000 +1,2000 @@\n"))
+}
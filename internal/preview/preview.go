@@ -0,0 +1,34 @@
+// Package preview formats values for use in failure messages, truncating them
+// so that a failure involving a huge slice or string remains readable.
+package preview
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// MaxLength is the maximum number of bytes of a formatted value that will be
+// included in a failure message.
+const MaxLength = 256
+
+// Value formats val with %#v, truncating the result to MaxLength.
+func Value(val any) string {
+	return Text(fmt.Sprintf("%#v", val))
+}
+
+// Text truncates s to MaxLength.
+func Text(s string) string {
+	return Truncate(s, MaxLength)
+}
+
+// Truncate shortens s to at most n bytes without splitting a multi-byte rune,
+// marking any truncation with a trailing "...".
+func Truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n] + "..."
+}
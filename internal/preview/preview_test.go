@@ -0,0 +1,37 @@
+package preview_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/internal/preview"
+)
+
+func TestValue(t *testing.T) {
+	t.Parallel()
+	check.Equal(t, `[]int{1, 2, 3}`, preview.Value([]int{1, 2, 3}))
+	check.Equal(t, `"hello"`, preview.Value("hello"))
+
+	long := preview.Value(strings.Repeat("x", 1000))
+	check.Equal(t, preview.MaxLength+len("..."), len(long))
+	check.HasSuffix(t, "...", long)
+}
+
+func TestText(t *testing.T) {
+	t.Parallel()
+	check.Equal(t, "hello", preview.Text("hello"))
+	check.Equal(t, strings.Repeat("x", preview.MaxLength), preview.Text(strings.Repeat("x", preview.MaxLength)))
+	check.Equal(t, strings.Repeat("x", preview.MaxLength)+"...", preview.Text(strings.Repeat("x", preview.MaxLength+1)))
+}
+
+func TestTruncate(t *testing.T) {
+	t.Parallel()
+	check.Equal(t, "hello", preview.Truncate("hello", 5))
+	check.Equal(t, "hel...", preview.Truncate("hello", 3))
+	check.Equal(t, "...", preview.Truncate("hello", 0))
+
+	// Multi-byte runes are never split.
+	check.Equal(t, "h...", preview.Truncate("héllo", 2))
+	check.Equal(t, "hé...", preview.Truncate("héllo", 3))
+}
@@ -1,4 +1,4 @@
-package main_test
+package testy_test
 
 import (
 	"bytes"
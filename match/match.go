@@ -0,0 +1,199 @@
+// Package match provides composable matchers for use with check.That and
+// assert.That, for conditions that the functions in check can't express on
+// their own:
+//
+//	check.That(t, users, match.Each(match.AllOf(
+//		match.Field("Age", func(u User) int { return u.Age }, match.Satisfies("> 18", func(age int) bool { return age > 18 })),
+//		match.Field("Name", func(u User) string { return u.Name }, match.Not(match.Eq(""))),
+//	)))
+//
+// When a matcher fails, its explanation shows exactly which part failed,
+// indented to follow the structure of the matcher:
+//
+//	expected got to match
+//	element [1] did not match:
+//	  field Age:
+//	    want: > 18
+//	     got: 17
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/peterldowns/testy/internal/preview"
+)
+
+// Matcher is a condition on values of type T. Match returns true if got
+// matches, along with an explanation: why got did not match, or a short
+// description of the match if it did, which is used by matchers like AllOf.
+//
+// The built-in matchers explain a failure with a line starting with "want: "
+// followed by a description of the condition, which Not uses to describe its
+// own matches.
+type Matcher[T any] interface {
+	Match(got T) (bool, string)
+}
+
+// MatcherFunc adapts a function to a Matcher.
+type MatcherFunc[T any] func(got T) (bool, string)
+
+func (f MatcherFunc[T]) Match(got T) (bool, string) {
+	return f(got)
+}
+
+// Eq matches values equal to want, compared with go-cmp. You can change the
+// behavior of the equality checking using the go-cmp/cmp Options system. For
+// more information, see [the go-cmp documentation](https://pkg.go.dev/github.com/google/go-cmp/cmp#Equal).
+func Eq[T any](want T, opts ...gocmp.Option) Matcher[T] {
+	return MatcherFunc[T](func(got T) (bool, string) {
+		if gocmp.Equal(want, got, opts...) {
+			return true, fmt.Sprintf("equal to %s", preview.Value(want))
+		}
+		return false, fmt.Sprintf("want: %s\n got: %s", preview.Value(want), preview.Value(got))
+	})
+}
+
+// Satisfies matches values for which fn returns true. description describes
+// the condition, like "> 18", and is shown when the matcher fails.
+func Satisfies[T any](description string, fn func(got T) bool) Matcher[T] {
+	return MatcherFunc[T](func(got T) (bool, string) {
+		if fn(got) {
+			return true, description
+		}
+		return false, fmt.Sprintf("want: %s\n got: %s", description, preview.Value(got))
+	})
+}
+
+// Not matches values that m does not match.
+func Not[T any](m Matcher[T]) Matcher[T] {
+	return MatcherFunc[T](func(got T) (bool, string) {
+		ok, explanation := m.Match(got)
+		if ok {
+			return false, fmt.Sprintf("want: not %s\n got: %s", explanation, preview.Value(got))
+		}
+		return true, negate(strings.TrimPrefix(firstLine(explanation), "want: "))
+	})
+}
+
+// AllOf matches values that every one of matchers matches. If any fail, the
+// explanation includes each failure.
+func AllOf[T any](matchers ...Matcher[T]) Matcher[T] {
+	return MatcherFunc[T](func(got T) (bool, string) {
+		var failures, descriptions []string
+		for _, m := range matchers {
+			ok, explanation := m.Match(got)
+			if ok {
+				descriptions = append(descriptions, explanation)
+			} else {
+				failures = append(failures, explanation)
+			}
+		}
+		if len(failures) > 0 {
+			return false, strings.Join(failures, "\n")
+		}
+		return true, strings.Join(descriptions, " and ")
+	})
+}
+
+// AnyOf matches values that at least one of matchers matches. If none do, the
+// explanation includes the failure of each one.
+func AnyOf[T any](matchers ...Matcher[T]) Matcher[T] {
+	return MatcherFunc[T](func(got T) (bool, string) {
+		var b strings.Builder
+		b.WriteString("none of these matched:")
+		for i, m := range matchers {
+			ok, explanation := m.Match(got)
+			if ok {
+				return true, explanation
+			}
+			fmt.Fprintf(&b, "\n  [%d]: %s", i, indent(explanation, "       "))
+		}
+		return false, b.String()
+	})
+}
+
+// HasLen matches arrays, channels, maps, slices, and strings of length n.
+func HasLen[T any](n int) Matcher[T] {
+	return MatcherFunc[T](func(got T) (bool, string) {
+		value := reflect.ValueOf(got)
+		switch value.Kind() {
+		case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+			if value.Len() == n {
+				return true, fmt.Sprintf("length %d", n)
+			}
+			return false, fmt.Sprintf("want: length %d\n got: length %d\nvalue: %s", n, value.Len(), preview.Value(got))
+		default:
+			return false, fmt.Sprintf("want: length %d\n got: a value without a length: %s", n, preview.Value(got))
+		}
+	})
+}
+
+// Each matches slices in which m matches every element. If some elements
+// don't match, the explanation includes the failure of each one.
+func Each[E any](m Matcher[E]) Matcher[[]E] {
+	return MatcherFunc[[]E](func(got []E) (bool, string) {
+		var failures []string
+		for i, element := range got {
+			if ok, explanation := m.Match(element); !ok {
+				failures = append(failures, fmt.Sprintf("element [%d] did not match:\n  %s", i, indent(explanation, "  ")))
+			}
+		}
+		if len(failures) > 0 {
+			return false, strings.Join(failures, "\n")
+		}
+		return true, "every element matched"
+	})
+}
+
+// ContainsElem matches slices with at least one element that m matches.
+func ContainsElem[E any](m Matcher[E]) Matcher[[]E] {
+	return MatcherFunc[[]E](func(got []E) (bool, string) {
+		var b strings.Builder
+		fmt.Fprintf(&b, "no element matched, got %d elements:", len(got))
+		for i, element := range got {
+			ok, explanation := m.Match(element)
+			if ok {
+				return true, fmt.Sprintf("element [%d] matched: %s", i, explanation)
+			}
+			fmt.Fprintf(&b, "\n  [%d]: %s", i, indent(explanation, "       "))
+		}
+		return false, b.String()
+	})
+}
+
+// Field matches values whose field, as returned by get, is matched by m. name
+// is used in the explanation.
+//
+//	match.Field("Name", func(u User) string { return u.Name }, match.Eq("peter"))
+func Field[T any, F any](name string, get func(T) F, m Matcher[F]) Matcher[T] {
+	return MatcherFunc[T](func(got T) (bool, string) {
+		ok, explanation := m.Match(get(got))
+		if ok {
+			return true, fmt.Sprintf("field %s %s", name, explanation)
+		}
+		return false, fmt.Sprintf("field %s:\n  %s", name, indent(explanation, "  "))
+	})
+}
+
+// indent adds prefix to every line of s but the first.
+func indent(s, prefix string) string {
+	return strings.ReplaceAll(s, "\n", "\n"+prefix)
+}
+
+// negate returns the negation of a matcher's description.
+func negate(description string) string {
+	if rest, ok := strings.CutPrefix(description, "not "); ok {
+		return rest
+	}
+	return "not " + description
+}
+
+// firstLine returns the first line of s.
+func firstLine(s string) string {
+	first, _, _ := strings.Cut(s, "\n")
+	return first
+}
@@ -0,0 +1,129 @@
+package match_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/match"
+)
+
+type user struct {
+	Name string
+	Age  int
+}
+
+func name(u user) string { return u.Name }
+func age(u user) int     { return u.Age }
+
+var adult = match.Satisfies("> 18", func(age int) bool { return age > 18 })
+
+// matches returns the result of m.Match(got) as a single value, for easy
+// comparison.
+func matches[T any](m match.Matcher[T], got T) []any {
+	ok, explanation := m.Match(got)
+	return []any{ok, explanation}
+}
+
+func TestEq(t *testing.T) {
+	t.Parallel()
+	check.Equal(t, []any{true, "equal to 5"}, matches(match.Eq(5), 5))
+	check.Equal(t, []any{false, "want: 5\n got: 4"}, matches(match.Eq(5), 4))
+	check.Equal(t, []any{false, "want: \"peter\"\n got: \"bob\""}, matches(match.Eq("peter"), "bob"))
+}
+
+func TestSatisfies(t *testing.T) {
+	t.Parallel()
+	check.Equal(t, []any{true, "> 18"}, matches(adult, 30))
+	check.Equal(t, []any{false, "want: > 18\n got: 17"}, matches(adult, 17))
+}
+
+func TestNot(t *testing.T) {
+	t.Parallel()
+	check.Equal(t, []any{true, "not 5"}, matches(match.Not(match.Eq(5)), 4))
+	check.Equal(t, []any{false, "want: not equal to 5\n got: 5"}, matches(match.Not(match.Eq(5)), 5))
+	check.Equal(t, []any{true, "equal to 5"}, matches(match.Not(match.Not(match.Eq(5))), 5))
+}
+
+func TestAllOf(t *testing.T) {
+	t.Parallel()
+	m := match.AllOf(adult, match.Not(match.Eq(40)))
+	check.Equal(t, []any{true, "> 18 and not 40"}, matches(m, 30))
+	check.Equal(t, []any{false, "want: > 18\n got: 17"}, matches(m, 17))
+	check.Equal(t, []any{false, "want: not equal to 40\n got: 40"}, matches(m, 40))
+	check.Equal(t, []any{true, ""}, matches(match.AllOf[int](), 1))
+}
+
+func TestAnyOf(t *testing.T) {
+	t.Parallel()
+	m := match.AnyOf(match.Eq(1), match.Eq(2))
+	check.Equal(t, []any{true, "equal to 2"}, matches(m, 2))
+	check.Equal(t, []any{false, strings.Join([]string{
+		"none of these matched:",
+		"  [0]: want: 1",
+		"        got: 3",
+		"  [1]: want: 2",
+		"        got: 3",
+	}, "\n")}, matches(m, 3))
+}
+
+func TestHasLen(t *testing.T) {
+	t.Parallel()
+	check.Equal(t, []any{true, "length 2"}, matches(match.HasLen[[]int](2), []int{1, 2}))
+	check.Equal(t, []any{true, "length 5"}, matches(match.HasLen[string](5), "hello"))
+	check.Equal(t, []any{true, "length 1"}, matches(match.HasLen[map[string]int](1), map[string]int{"a": 1}))
+	check.Equal(t, []any{false, "want: length 3\n got: length 2\nvalue: []int{1, 2}"}, matches(match.HasLen[[]int](3), []int{1, 2}))
+	check.Equal(t, []any{false, "want: length 3\n got: a value without a length: 5"}, matches(match.HasLen[int](3), 5))
+}
+
+func TestEach(t *testing.T) {
+	t.Parallel()
+	m := match.Each(match.AllOf(
+		match.Field("Age", age, adult),
+		match.Field("Name", name, match.Not(match.Eq(""))),
+	))
+	check.Equal(t, []any{true, "every element matched"}, matches(m, []user{{Name: "peter", Age: 30}}))
+	check.Equal(t, []any{true, "every element matched"}, matches(m, nil))
+	check.Equal(t, []any{false, strings.Join([]string{
+		"element [1] did not match:",
+		"  field Age:",
+		"    want: > 18",
+		"     got: 17",
+		"element [2] did not match:",
+		"  field Age:",
+		"    want: > 18",
+		"     got: 12",
+		"  field Name:",
+		"    want: not equal to \"\"",
+		"     got: \"\"",
+	}, "\n")}, matches(m, []user{
+		{Name: "peter", Age: 30},
+		{Name: "bob", Age: 17},
+		{Name: "", Age: 12},
+	}))
+}
+
+func TestContainsElem(t *testing.T) {
+	t.Parallel()
+	m := match.ContainsElem(match.Field("Name", name, match.Eq("peter")))
+	check.Equal(t, []any{true, `element [1] matched: field Name equal to "peter"`}, matches(m, []user{{Name: "bob"}, {Name: "peter"}}))
+	check.Equal(t, []any{false, strings.Join([]string{
+		"no element matched, got 2 elements:",
+		"  [0]: field Name:",
+		`         want: "peter"`,
+		`          got: "bob"`,
+		"  [1]: field Name:",
+		`         want: "peter"`,
+		`          got: "alice"`,
+	}, "\n")}, matches(m, []user{{Name: "bob"}, {Name: "alice"}}))
+	check.Equal(t, []any{false, "no element matched, got 0 elements:"}, matches(m, nil))
+}
+
+func TestMatcherFunc(t *testing.T) {
+	t.Parallel()
+	even := match.MatcherFunc[int](func(got int) (bool, string) {
+		return got%2 == 0, "even"
+	})
+	check.Equal(t, []any{true, "even"}, matches[int](even, 2))
+	check.Equal(t, []any{false, "element [0] did not match:\n  even"}, matches(match.Each[int](even), []int{1}))
+}
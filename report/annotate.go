@@ -0,0 +1,62 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/peterldowns/testy/common"
+)
+
+// Annotate returns a common.T that adds annotation as a prefix to every
+// failure reported through it. Annotations nest, so a failure reported
+// through Annotate(Annotate(t, "user 1"), "order 2") starts with
+// "user 1: order 2: ".
+//
+// Everything other than the failure message, like the test's reporter and
+// name, comes from t.
+func Annotate(t common.T, annotation string) common.T {
+	return &annotated{T: t, annotation: annotation}
+}
+
+// annotated is the common.T returned by Annotate. Helper is promoted from the
+// embedded T rather than wrapped, so that t.Helper() marks the function that
+// called it and not a method of annotated.
+type annotated struct {
+	common.T
+	annotation string
+}
+
+func (a *annotated) Error(args ...any) {
+	a.T.Helper()
+	a.T.Error(a.annotation + ": " + fmt.Sprint(args...))
+}
+
+// Unwrap returns the test that t annotates, or t itself if it was not
+// returned by [Annotate].
+func Unwrap(t common.T) common.T {
+	for {
+		a, ok := t.(*annotated)
+		if !ok {
+			return t
+		}
+		t = a.T
+	}
+}
+
+// annotations returns the prefix that the annotations of t add to a failure
+// message, outermost first, or "" if t isn't annotated.
+func annotations(t common.T) string {
+	var prefixes []string
+	for {
+		a, ok := t.(*annotated)
+		if !ok {
+			break
+		}
+		prefixes = append([]string{a.annotation}, prefixes...)
+		t = a.T
+	}
+	if len(prefixes) == 0 {
+		return ""
+	}
+	return strings.Join(prefixes, ": ") + ": "
+}
@@ -0,0 +1,88 @@
+package report
+
+import (
+	"runtime"
+	"strings"
+)
+
+// Caller is a location in the source code.
+type Caller struct {
+	File     string
+	Line     int
+	Function string
+}
+
+// modulePrefix is the import path prefix of every Testy package.
+const modulePrefix = "github.com/peterldowns/testy"
+
+// testyPackages are the packages whose functions report failures. Frames in
+// these packages are skipped when looking for the caller of a check.
+var testyPackages = []string{
+	modulePrefix + ".",
+	modulePrefix + "/assert.",
+	modulePrefix + "/check.",
+	modulePrefix + "/golden.",
+	modulePrefix + "/report.",
+	modulePrefix + "/testytest.",
+}
+
+// fromStack walks up the call stack from its caller and returns the name and
+// location of the outermost Testy function, along with the location that
+// called it.
+func fromStack() (string, Caller, Caller) {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	check := ""
+	var checkAt Caller
+	for {
+		frame, more := frames.Next()
+		if !isTestyFunction(frame.Function) {
+			return check, checkAt, Caller{File: frame.File, Line: frame.Line, Function: frame.Function}
+		}
+		// The report package and the handles returned by testy.New pass
+		// failures along, but the check is named after the function in
+		// check, assert, or golden that they call.
+		if !strings.HasPrefix(frame.Function, modulePrefix+"/report.") && !strings.HasPrefix(frame.Function, modulePrefix+".") {
+			check = shortName(frame.Function)
+			checkAt = Caller{File: frame.File, Line: frame.Line, Function: frame.Function}
+		}
+		if !more {
+			return check, checkAt, Caller{}
+		}
+	}
+}
+
+// isTestyFunction returns true if function is in one of the Testy packages
+// that report failures.
+func isTestyFunction(function string) bool {
+	for _, prefix := range testyPackages {
+		if strings.HasPrefix(function, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// shortName turns a fully-qualified function name like
+// "github.com/peterldowns/testy/check.Equal[...].func1" into "check.Equal", and
+// a method like "github.com/peterldowns/testy/check.(*Group).Wait" into
+// "check.Group.Wait".
+func shortName(function string) string {
+	name := function[strings.LastIndex(function, "/")+1:]
+	if i := strings.Index(name, "["); i >= 0 {
+		if j := strings.LastIndex(name, "]"); j > i {
+			name = name[:i] + name[j+1:]
+		}
+	}
+	if i := strings.Index(name, ".func"); i >= 0 {
+		name = name[:i]
+	}
+	return strings.NewReplacer("(*", "", ")", "").Replace(name)
+}
+
+// isFatal returns true if check is the name of a function that stops the test
+// when it fails.
+func isFatal(check string) bool {
+	return strings.HasPrefix(check, "assert.") || strings.HasPrefix(check, "golden.Assert")
+}
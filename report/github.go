@@ -0,0 +1,101 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterldowns/testy/common"
+)
+
+// OutputEnv is the environment variable that chooses the default reporter:
+//
+//   - "plain" (or unset) uses [Plain]
+//   - "color" uses [Color]
+//   - "compact" uses [Compact]
+//   - "github" uses [GitHub], which is useful in GitHub Actions workflows
+const OutputEnv = "TESTY_OUTPUT"
+
+// fromEnv returns the default reporter chosen by OutputEnv.
+func fromEnv() Reporter {
+	switch strings.ToLower(os.Getenv(OutputEnv)) {
+	case "color":
+		return Color{}
+	case "compact":
+		return Compact{}
+	case "github":
+		return GitHub{}
+	default:
+		return Plain{}
+	}
+}
+
+// GitHub prints each failure as a GitHub Actions workflow command, so that it
+// is shown as an annotation on the line of the test that failed:
+//
+//	::error file=pkg/foo_test.go,line=12,title=check.Equal::expected want == got%0A...
+//
+// and then passes the failure to Reporter, or to [Plain] if Reporter is nil,
+// so it's also shown in the test output. File paths are made relative to
+// GITHUB_WORKSPACE so that they match the paths in the repository.
+type GitHub struct {
+	Reporter Reporter
+	// Output is where the workflow commands are written. If nil, they're
+	// written to stdout, where GitHub Actions looks for them.
+	Output io.Writer
+}
+
+func (g GitHub) Report(t common.T, f Failure) {
+	t.Helper()
+	out := g.Output
+	if out == nil {
+		out = os.Stdout
+	}
+	fmt.Fprintln(out, annotation(f))
+	r := g.Reporter
+	if r == nil {
+		r = Plain{}
+	}
+	r.Report(t, f)
+}
+
+// annotation returns the ::error workflow command describing f.
+func annotation(f Failure) string {
+	var properties []string
+	if f.Caller.File != "" {
+		properties = append(properties,
+			"file="+escapeProperty(workspacePath(f.Caller.File)),
+			fmt.Sprintf("line=%d", f.Caller.Line),
+		)
+	}
+	if f.Check != "" {
+		properties = append(properties, "title="+escapeProperty(f.Check))
+	}
+	return fmt.Sprintf("::error %s::%s", strings.Join(properties, ","), escapeData(f.Message))
+}
+
+// workspacePath returns path relative to the GITHUB_WORKSPACE directory, if
+// it is inside it.
+func workspacePath(path string) string {
+	workspace := os.Getenv("GITHUB_WORKSPACE")
+	if workspace == "" {
+		return path
+	}
+	rel, err := filepath.Rel(workspace, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return path
+	}
+	return filepath.ToSlash(rel)
+}
+
+// escapeData escapes the message of a workflow command.
+func escapeData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeProperty escapes the value of a workflow command property.
+func escapeProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
@@ -0,0 +1,42 @@
+package report_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/report"
+)
+
+//nolint:paralleltest // uses t.Setenv
+func TestGitHub(t *testing.T) {
+	workspace := t.TempDir()
+	t.Setenv("GITHUB_WORKSPACE", workspace)
+
+	var out bytes.Buffer
+	mt := &common.MockT{}
+	report.GitHub{Output: &out}.Report(mt, report.Failure{
+		Check:   "check.Equal",
+		Message: "expected want == got\n--- want\n+++ got\n-100%\n+50%",
+		Caller: report.Caller{
+			File: filepath.Join(workspace, "pkg", "foo_test.go"),
+			Line: 12,
+		},
+	})
+	check.Equal(t, "::error file=pkg/foo_test.go,line=12,title=check.Equal::expected want == got%0A--- want%0A+++ got%0A-100%25%0A+50%25\n", out.String())
+	check.Equal(t, []string{"expected want == got\n--- want\n+++ got\n-100%\n+50%"}, mt.Errors())
+
+	// Files outside of the workspace keep their full path, and the failure
+	// is passed to the wrapped reporter.
+	out.Reset()
+	mt = &common.MockT{}
+	report.GitHub{Reporter: report.Compact{}, Output: &out}.Report(mt, report.Failure{
+		Check:   "assert.True",
+		Message: "expected true",
+		Caller:  report.Caller{File: "/elsewhere/a,b_test.go", Line: 3},
+	})
+	check.Equal(t, "::error file=/elsewhere/a%2Cb_test.go,line=3,title=assert.True::expected true\n", out.String())
+	check.Equal(t, []string{"assert.True: expected true"}, mt.Errors())
+}
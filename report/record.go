@@ -0,0 +1,144 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/peterldowns/testy/common"
+)
+
+// RecordEnv is the environment variable that names the file that failure
+// records are written to. If it is empty, no records are written.
+//
+// Because go test runs the tests for each package in that package's
+// directory, this should be an absolute path.
+const RecordEnv = "TESTY_REPORT"
+
+// Record is the machine-readable form of a [Failure]. When [RecordEnv] is
+// set, a Record is appended to the file it names for every failure, encoded
+// as a single line of JSON.
+type Record struct {
+	// Package is the import path of the package being tested, matching the
+	// Package field of `go test -json` output.
+	Package string `json:"package"`
+	// Test is the name of the failing test, matching the Test field of
+	// `go test -json` output.
+	Test    string `json:"test,omitempty"`
+	Check   string `json:"check"`
+	Fatal   bool   `json:"fatal,omitempty"`
+	File    string `json:"file,omitempty"`
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+	Want    string `json:"want,omitempty"`
+	Got     string `json:"got,omitempty"`
+	Diff    string `json:"diff,omitempty"`
+	Source  string `json:"source,omitempty"`
+}
+
+// NewRecord returns the Record describing the failure f in test t. It must be
+// called on the goroutine running the test, so that the record's Package can
+// be found from the test function, even when the check was called from a
+// helper in another package. Otherwise, the package of f.Caller is used.
+func NewRecord(t common.T, f Failure) Record {
+	function := testFunction()
+	if function == "" {
+		function = f.Caller.Function
+	}
+	r := Record{
+		Package: packageOf(function),
+		Test:    common.AsTB(Unwrap(t)).Name(),
+		Check:   f.Check,
+		Fatal:   f.Fatal,
+		File:    f.Caller.File,
+		Line:    f.Caller.Line,
+		Message: f.Message,
+		Diff:    f.Diff,
+		Source:  f.Source,
+	}
+	if f.Want != nil {
+		r.Want = fmt.Sprintf("%#v", f.Want)
+	}
+	if f.Got != nil {
+		r.Got = fmt.Sprintf("%#v", f.Got)
+	}
+	return r
+}
+
+// testFunction returns the name of the test function running on the calling
+// goroutine, which is the outermost function called by testing.tRunner, or ""
+// if the goroutine isn't running a test.
+func testFunction() string {
+	pcs := make([]uintptr, 64)
+	for {
+		n := runtime.Callers(2, pcs)
+		if n < len(pcs) {
+			pcs = pcs[:n]
+			break
+		}
+		pcs = make([]uintptr, 2*len(pcs))
+	}
+	frames := runtime.CallersFrames(pcs)
+	outermost := ""
+	for {
+		frame, more := frames.Next()
+		if frame.Function == "testing.tRunner" {
+			return outermost
+		}
+		outermost = frame.Function
+		if !more {
+			return ""
+		}
+	}
+}
+
+// packageOf returns the import path of the package containing function,
+// treating external test packages like "example.com/foo_test" as the package
+// they test.
+func packageOf(function string) string {
+	slash := strings.LastIndex(function, "/")
+	dot := strings.Index(function[slash+1:], ".")
+	if dot == -1 {
+		return ""
+	}
+	return strings.TrimSuffix(function[:slash+1+dot], "_test")
+}
+
+var recordMu sync.Mutex
+
+// writeRecord appends the record for f to the file named by RecordEnv, if it
+// is set. Errors are logged to stderr rather than failing the test, since the
+// test has already failed.
+func writeRecord(t common.T, f Failure) {
+	path := os.Getenv(RecordEnv)
+	if path == "" {
+		return
+	}
+	record := NewRecord(t, f)
+	if record.Test == "" {
+		// Failures in a test without a name, like the mock tests run by
+		// testytest, aren't failures of any test that go test knows about.
+		return
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "testy: failed to encode failure record: %s\n", err)
+		return
+	}
+	recordMu.Lock()
+	defer recordMu.Unlock()
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "testy: failed to open %s: %s\n", RecordEnv, err)
+		return
+	}
+	defer file.Close()
+	// A single write keeps each line intact when the tests for several
+	// packages append to the same file at once.
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		fmt.Fprintf(os.Stderr, "testy: failed to write %s: %s\n", RecordEnv, err)
+	}
+}
@@ -0,0 +1,83 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/report"
+)
+
+//nolint:paralleltest // uses t.Setenv
+func TestRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testy.ndjson")
+	t.Setenv(report.RecordEnv, path)
+
+	mt := &common.MockT{TestName: "TestSomething/subtest"}
+	_, file, line, _ := runtime.Caller(0)
+	check.Equal(mt, "hello", "world")
+	assert.NoError(mt, os.ErrNotExist)
+	check.True(t, mt.FailedNow())
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	lines := bytes.Split(bytes.TrimSpace(data), []byte("\n"))
+	assert.Equal(t, 2, len(lines))
+
+	var records []report.Record
+	for _, line := range lines {
+		var r report.Record
+		assert.NoError(t, json.Unmarshal(line, &r))
+		records = append(records, r)
+	}
+	check.Equal(t, report.Record{
+		Package: "github.com/peterldowns/testy/report",
+		Test:    "TestSomething/subtest",
+		Check:   "check.Equal",
+		File:    file,
+		Line:    line + 1,
+		Message: "expected want == got\nwant: \"hello\"\n got: \"world\"\n       ^",
+		Want:    `"hello"`,
+		Got:     `"world"`,
+		Diff:    "want: \"hello\"\n got: \"world\"\n       ^",
+	}, records[0])
+	check.Equal(t, report.Record{
+		Package: "github.com/peterldowns/testy/report",
+		Test:    "TestSomething/subtest",
+		Check:   "assert.NoError",
+		Fatal:   true,
+		File:    file,
+		Line:    line + 2,
+		Message: "expected <nil> error, received &errors.errorString{s:\"file does not exist\"}",
+		Got:     "&errors.errorString{s:\"file does not exist\"}",
+	}, records[1])
+}
+
+func TestRecordPackage(t *testing.T) {
+	t.Parallel()
+	// A check called from a helper in another package is recorded under the
+	// package of the test that called the helper.
+	r := report.NewRecord(&common.MockT{}, report.Failure{
+		Check:  "check.True",
+		Caller: report.Caller{Function: "example.com/internal/testutil.Helper"},
+	})
+	check.Equal(t, "github.com/peterldowns/testy/report", r.Package)
+
+	// Off of the test's goroutine, the caller's package is used.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r = report.NewRecord(&common.MockT{}, report.Failure{
+			Check:  "check.True",
+			Caller: report.Caller{Function: "example.com/internal/testutil.Helper"},
+		})
+	}()
+	<-done
+	check.Equal(t, "example.com/internal/testutil", r.Package)
+}
@@ -0,0 +1,135 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/internal/preview"
+)
+
+// Plain renders failures as multi-line plain text. It is the default
+// reporter.
+type Plain struct{}
+
+func (Plain) Report(t common.T, f Failure) {
+	t.Helper()
+	t.Error(f.Message)
+}
+
+// Color renders failures like [Plain], but highlights the summary and diff
+// lines with ANSI colors when stdout is a terminal and the NO_COLOR
+// environment variable is empty.
+type Color struct {
+	// Always enables colors even if stdout is not a terminal, which is useful
+	// in CI systems that render ANSI colors in their logs.
+	Always bool
+}
+
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiRed    = "\x1b[31m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[33m"
+	ansiCyan   = "\x1b[36m"
+)
+
+func (c Color) Report(t common.T, f Failure) {
+	t.Helper()
+	if !c.enabled() {
+		t.Error(f.Message)
+		return
+	}
+	summary, rest := cutLine(f.Message)
+	var b strings.Builder
+	b.WriteString(ansiBold + ansiRed + summary + ansiReset)
+	if rest != "" {
+		for _, line := range strings.Split(rest, "\n") {
+			b.WriteString("\n")
+			b.WriteString(colorLine(line))
+		}
+	}
+	t.Error(b.String())
+}
+
+func (c Color) enabled() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	if c.Always {
+		return true
+	}
+	info, err := os.Stdout.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// colorLine highlights a single line of a failure message based on its
+// prefix: removed and added lines of a diff, hunk headers, and carets.
+func colorLine(line string) string {
+	switch {
+	case strings.HasPrefix(line, "--- "), strings.HasPrefix(line, "+++ "):
+		return ansiBold + line + ansiReset
+	case strings.HasPrefix(line, "@@"):
+		return ansiCyan + line + ansiReset
+	case strings.HasPrefix(line, "-"):
+		return ansiRed + line + ansiReset
+	case strings.HasPrefix(line, "+"):
+		return ansiGreen + line + ansiReset
+	case strings.TrimLeft(line, " ^") == "" && strings.Contains(line, "^"):
+		return ansiYellow + line + ansiReset
+	default:
+		return line
+	}
+}
+
+// Compact renders each failure as a single line, which is easier to scan when
+// many checks fail at once.
+//
+//	check.Equal: expected want == got (want: 1, got: 2)
+type Compact struct{}
+
+// maxCompactValueLength is the maximum number of bytes of each value shown by
+// Compact.
+const maxCompactValueLength = 60
+
+func (Compact) Report(t common.T, f Failure) {
+	t.Helper()
+	summary, rest := cutLine(f.Message)
+	line := summary
+	if f.Check != "" {
+		line = f.Check + ": " + line
+	}
+	var values []string
+	if f.Want != nil {
+		values = append(values, "want: "+compactValue(f.Want))
+	}
+	if f.Got != nil {
+		values = append(values, "got: "+compactValue(f.Got))
+	}
+	switch {
+	case len(values) > 0:
+		line += " (" + strings.Join(values, ", ") + ")"
+	case rest != "":
+		var details []string
+		for _, detail := range strings.Split(rest, "\n") {
+			if detail = strings.TrimSpace(detail); detail != "" {
+				details = append(details, detail)
+			}
+		}
+		line += ": " + preview.Truncate(strings.Join(details, "; "), 4*maxCompactValueLength)
+	}
+	t.Error(line)
+}
+
+// compactValue formats val on a single line.
+func compactValue(val any) string {
+	return preview.Truncate(fmt.Sprintf("%#v", val), maxCompactValueLength)
+}
+
+// cutLine splits s into its first line and the rest.
+func cutLine(s string) (string, string) {
+	first, rest, _ := strings.Cut(s, "\n")
+	return first, rest
+}
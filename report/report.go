@@ -0,0 +1,156 @@
+// Package report controls how Testy describes failed checks and assertions.
+//
+// Every function in check, assert, and golden describes its failure as a
+// [Failure] and passes it to a [Reporter], which decides how to render it. By
+// default failures are rendered as plain text with t.Error(), but you can
+// change the reporter for every test with [SetDefault] or the TESTY_OUTPUT
+// environment variable ([OutputEnv]), or for a single test with [Use]:
+//
+//	func TestMain(m *testing.M) {
+//		report.SetDefault(report.Color{})
+//		os.Exit(m.Run())
+//	}
+//
+//	func TestSomething(t *testing.T) {
+//		report.Use(t, report.Compact{})
+//		check.Equal(t, 1, 2)
+//	}
+package report
+
+import (
+	"reflect"
+	"sync"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/peterldowns/testy/common"
+)
+
+// Failure describes a single failed check or assertion.
+type Failure struct {
+	// Check is the name of the function that failed, like "check.Equal" or
+	// "assert.NoError".
+	Check string
+	// Fatal is true if the failure stops the test, as with the functions in
+	// assert.
+	Fatal bool
+	// Message is the full, plain-text description of the failure, exactly as
+	// it is shown by [Plain]. The first line is a short summary.
+	Message string
+	// Want and Got are the expected and actual values, if the check compares
+	// values. Either may be nil.
+	Want any
+	Got  any
+	// Diff is a human-readable description of the differences between Want
+	// and Got, if the check computes one.
+	Diff string
+	// Options are the go-cmp options passed to the check, if any.
+	Options []gocmp.Option
+	// Caller is the location in the test that called the check.
+	Caller Caller
+	// Source shows the expressions that the caller passed to the check, read
+	// from the caller's source file, if source capture is enabled with
+	// [ShowSource].
+	Source string
+}
+
+// Summary returns the first line of the failure's message.
+func (f Failure) Summary() string {
+	summary, _ := cutLine(f.Message)
+	return summary
+}
+
+// Reporter renders failures. Report is called with the test that failed and
+// must mark it as failed, usually by calling t.Error(). Testy calls
+// t.FailNow() itself after reporting a fatal failure.
+type Reporter interface {
+	Report(t common.T, f Failure)
+}
+
+var (
+	mu              sync.RWMutex
+	defaultReporter = fromEnv()
+	perTest         = map[common.T]Reporter{}
+)
+
+// SetDefault sets the reporter used by every test that doesn't have its own
+// reporter set with [Use]. Passing nil restores the default, which is chosen
+// by [OutputEnv].
+func SetDefault(r Reporter) {
+	if r == nil {
+		r = fromEnv()
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	defaultReporter = r
+}
+
+// Use sets the reporter for a single test, overriding the default. If t, or
+// the test it annotates, has a Cleanup method, like *testing.T, the reporter
+// is removed when the test finishes. Subtests don't inherit their parent's
+// reporter. Passing nil restores the default reporter for t.
+func Use(t common.T, r Reporter) {
+	if !reflect.TypeOf(t).Comparable() {
+		return
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if r == nil {
+		delete(perTest, t)
+		return
+	}
+	if _, registered := perTest[t]; !registered {
+		common.AsTB(Unwrap(t)).Cleanup(func() { Use(t, nil) })
+	}
+	perTest[t] = r
+}
+
+// For returns the reporter that will be used for failures in t. If t was
+// returned by [Annotate], the reporter set for the test it annotates is used.
+func For(t common.T) Reporter {
+	mu.RLock()
+	defer mu.RUnlock()
+	for {
+		if reflect.TypeOf(t).Comparable() {
+			if r, ok := perTest[t]; ok {
+				return r
+			}
+		}
+		a, ok := t.(*annotated)
+		if !ok {
+			return defaultReporter
+		}
+		t = a.T
+	}
+}
+
+// Report passes f to the reporter for t. If f.Check or f.Caller are not set,
+// they're filled in from the call stack, which lets check and assert describe
+// a failure without knowing which of them was called by the test. If source
+// capture is enabled with [ShowSource], the expressions passed to the check
+// are added to the message. If [RecordEnv] is set, a [Record] of the failure
+// is also written to the file it names. If t was returned by [Annotate], its
+// annotations are added to the start of the message, and the reporter is
+// passed the test that t annotates.
+func Report(t common.T, f Failure) {
+	t.Helper()
+	f.Message = annotations(t) + f.Message
+	check, checkAt, caller := fromStack()
+	if f.Check == "" {
+		f.Check = check
+	}
+	if f.Caller.File == "" {
+		f.Caller = caller
+	}
+	if isFatal(f.Check) {
+		f.Fatal = true
+	}
+	if f.Source == "" && ShowingSource() {
+		f.Source = source(f, checkAt)
+		if f.Source != "" {
+			f.Message += "\n" + f.Source
+		}
+	}
+	writeRecord(t, f)
+	For(t).Report(Unwrap(t), f)
+}
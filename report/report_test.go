@@ -0,0 +1,251 @@
+package report_test
+
+import (
+	"cmp"
+	"errors"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/report"
+)
+
+// recorder is a Reporter that remembers every failure.
+type recorder struct {
+	failures []report.Failure
+}
+
+func (r *recorder) Report(t common.T, f report.Failure) {
+	t.Helper()
+	r.failures = append(r.failures, f)
+	t.Fail()
+}
+
+func TestFailure(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	r := &recorder{}
+	report.Use(mt, r)
+	_, file, line, _ := runtime.Caller(0)
+	check.Equal(mt, 1, 2)
+	check.True(t, mt.Failed())
+
+	assert.Equal(t, 1, len(r.failures))
+	f := r.failures[0]
+	check.Equal(t, "check.Equal", f.Check)
+	check.False(t, f.Fatal)
+	check.Equal(t, "expected want == got", f.Summary())
+	check.Equal[any](t, 1, f.Want)
+	check.Equal[any](t, 2, f.Got)
+	check.NotEqual(t, "", f.Diff)
+	check.Equal(t, file, f.Caller.File)
+	check.Equal(t, line+1, f.Caller.Line)
+	check.HasSuffix(t, ".TestFailure", f.Caller.Function)
+}
+
+func TestFailureFatal(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	r := &recorder{}
+	report.Use(mt, r)
+	assert.HasPrefix(mt, "/usr", "/var/usr")
+	check.True(t, mt.FailedNow())
+
+	assert.Equal(t, 1, len(r.failures))
+	f := r.failures[0]
+	check.Equal(t, "assert.HasPrefix", f.Check)
+	check.True(t, f.Fatal)
+	check.Equal[any](t, "/usr", f.Want)
+	check.Equal[any](t, "/var/usr", f.Got)
+	check.Equal(t, "report_test.go", filepath.Base(f.Caller.File))
+}
+
+func TestFailureDefined(t *testing.T) {
+	t.Parallel()
+	isEven := check.Define(func(n int) (bool, string) {
+		return n%2 == 0, "expected an even number"
+	})
+	mt := &common.MockT{}
+	r := &recorder{}
+	report.Use(mt, r)
+	_, file, line, _ := runtime.Caller(0)
+	isEven(mt, 3)
+	assert.Must(isEven)(mt, 5)
+
+	assert.Equal(t, 2, len(r.failures))
+	check.Equal(t, "check.Define", r.failures[0].Check)
+	check.False(t, r.failures[0].Fatal)
+	check.Equal(t, "assert.Must", r.failures[1].Check)
+	check.True(t, r.failures[1].Fatal)
+	for i, f := range r.failures {
+		check.Equal[any](t, 3+2*i, f.Got)
+		check.Equal(t, file, f.Caller.File)
+		check.Equal(t, line+1+i, f.Caller.Line)
+	}
+}
+
+func TestFailureGroup(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	r := &recorder{}
+	report.Use(mt, r)
+	g := check.Go(mt, func(t common.T) { check.True(t, false) })
+	g.Wait()
+	assert.Go(mt, func(t common.T) { check.True(t, false) }).Wait()
+	g = check.Go(mt, func(t common.T) { t.FailNow() })
+	g.Wait()
+
+	assert.Equal(t, 3, len(r.failures))
+	check.Equal(t, "check.Group.Wait", r.failures[0].Check)
+	check.False(t, r.failures[0].Fatal)
+	check.Equal(t, "assert.Group.Wait", r.failures[1].Check)
+	check.True(t, r.failures[1].Fatal)
+	check.Equal(t, "check.Group.Wait", r.failures[2].Check)
+	check.True(t, r.failures[2].Fatal)
+	for _, f := range r.failures {
+		check.Equal(t, "report_test.go", filepath.Base(f.Caller.File))
+	}
+}
+
+func TestUse(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	r := &recorder{}
+	original := report.For(mt)
+	report.Use(mt, r)
+	check.True(t, report.For(mt) == report.Reporter(r))
+	report.Use(mt, nil)
+	check.True(t, report.For(mt) == original)
+}
+
+func TestUseAnnotated(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	at := report.Annotate(mt, "annotated")
+	original := report.For(at)
+	r := &recorder{}
+	mt.Run(func(mt *common.MockT) {
+		report.Use(at, r)
+		check.True(t, report.For(at) == report.Reporter(r))
+	})
+	// The reporter is removed by the cleanup of the annotated test.
+	check.True(t, report.For(at) == original)
+}
+
+//nolint:paralleltest // changes the default reporter for every test
+func TestSetDefault(t *testing.T) {
+	mt := &common.MockT{}
+	original := report.For(mt)
+	r := &recorder{}
+	report.SetDefault(r)
+	defer report.SetDefault(nil)
+
+	check.True(t, report.For(mt) == report.Reporter(r))
+	check.False(mt, true)
+	check.True(t, mt.Failed())
+	check.Equal(t, 1, len(r.failures))
+
+	report.SetDefault(nil)
+	check.True(t, report.For(mt) == original)
+}
+
+func TestPlain(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	report.Plain{}.Report(mt, report.Failure{Message: "expected true"})
+	check.True(t, mt.Failed())
+	check.Equal(t, []string{"expected true"}, mt.Errors())
+}
+
+func TestCompact(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	report.Use(mt, report.Compact{})
+	check.Equal(mt, 1, 2)
+	check.ElementsMatch(mt, []int{1}, []int{2})
+	check.Panics(mt, func() {})
+	check.NoError(mt, errors.New("oops"))
+	check.Contains(mt, "ok", strings.Repeat("x", 100))
+	check.LessThan(mt, 2, 1)
+	check.GreaterThanOrEqualFunc(mt, 1, 2, cmp.Compare[int])
+	check.Equal(t, []string{
+		"check.Equal: expected want == got (want: 1, got: 2)",
+		"check.ElementsMatch: expected want and got to contain the same elements (want: []int{1}, got: []int{2})",
+		"check.Panics: expected function to panic",
+		`check.NoError: expected <nil> error, received &errors.errorString{s:"oops"} (got: &errors.errorString{s:"oops"})`,
+		`check.Contains: expected s to contain substring (want: "ok", got: "` + strings.Repeat("x", 59) + `...)`,
+		"check.LessThan: expected 2 < 1 (want: 1, got: 2)",
+		"check.GreaterThanOrEqualFunc: expected 1 >= 2 (want: 2, got: 1)",
+	}, mt.Errors())
+
+	mt = &common.MockT{}
+	report.Compact{}.Report(mt, report.Failure{Message: "expected something\n  line one\n\n  line two"})
+	check.Equal(t, []string{"expected something: line one; line two"}, mt.Errors())
+}
+
+//nolint:paralleltest // uses t.Setenv
+func TestColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	message := "expected want == got\n--- want\n+++ got\n@@ -1 +1 @@\n-a\n+b\nwant: \"a\"\n       ^"
+
+	mt := &common.MockT{}
+	report.Color{Always: true}.Report(mt, report.Failure{Message: message})
+	check.Equal(t, 1, len(mt.Errors()))
+	check.Equal(t, strings.Join([]string{
+		"\x1b[1m\x1b[31mexpected want == got\x1b[0m",
+		"\x1b[1m--- want\x1b[0m",
+		"\x1b[1m+++ got\x1b[0m",
+		"\x1b[36m@@ -1 +1 @@\x1b[0m",
+		"\x1b[31m-a\x1b[0m",
+		"\x1b[32m+b\x1b[0m",
+		"want: \"a\"",
+		"\x1b[33m       ^\x1b[0m",
+	}, "\n"), mt.Errors()[0])
+
+	t.Setenv("NO_COLOR", "1")
+	mt = &common.MockT{}
+	report.Color{Always: true}.Report(mt, report.Failure{Message: message})
+	check.Equal(t, []string{message}, mt.Errors())
+}
+
+//nolint:paralleltest // uses t.Setenv
+func TestOutputEnv(t *testing.T) {
+	// Cleanups run in reverse, so this runs after t.Setenv restores the
+	// environment.
+	t.Cleanup(func() { report.SetDefault(nil) })
+	mt := &common.MockT{}
+	for value, want := range map[string]report.Reporter{
+		"":        report.Plain{},
+		"plain":   report.Plain{},
+		"color":   report.Color{},
+		"compact": report.Compact{},
+		"GitHub":  report.GitHub{},
+		"unknown": report.Plain{},
+	} {
+		t.Setenv(report.OutputEnv, value)
+		report.SetDefault(nil)
+		check.Equal(t, want, report.For(mt))
+	}
+}
+
+func TestAnnotate(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	r := &recorder{}
+	report.Use(mt, r)
+	at := report.Annotate(report.Annotate(mt, "outer"), "inner")
+	check.True(t, report.Unwrap(at) == common.T(mt))
+	check.True(t, report.For(at) == report.Reporter(r))
+
+	_, file, line, _ := runtime.Caller(0)
+	check.Equal(at, 1, 2)
+	check.True(t, mt.Failed())
+	assert.Equal(t, 1, len(r.failures))
+	check.HasPrefix(t, "outer: inner: expected want == got", r.failures[0].Message)
+	check.Equal(t, file, r.failures[0].Caller.File)
+	check.Equal(t, line+1, r.failures[0].Caller.Line)
+}
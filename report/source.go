@@ -0,0 +1,235 @@
+package report
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// SourceEnv is the environment variable that enables source capture when it
+// is set to "1". See [ShowSource].
+const SourceEnv = "TESTY_SOURCE"
+
+var showSource atomic.Bool
+
+func init() {
+	showSource.Store(os.Getenv(SourceEnv) == "1")
+}
+
+// ShowSource enables or disables source capture for every test. When it is
+// enabled, failure messages include the expressions that the test passed to
+// the check, read from the test's source file:
+//
+//	expected true
+//	source: check.True(user.IsAdmin()) → false
+//
+//	expected want == got
+//	...
+//	source: check.Equal(expected.Name, resp.Name)
+//	  want: expected.Name
+//	   got: resp.Name
+//
+// If the source file isn't available, for instance because the test binary
+// was built on another machine, failure messages are shown without it.
+func ShowSource(enabled bool) {
+	showSource.Store(enabled)
+}
+
+// ShowingSource returns true if source capture is enabled.
+func ShowingSource() bool {
+	return showSource.Load()
+}
+
+// parsedFile is a parsed source file, along with its contents so that the
+// exact text of expressions can be shown.
+type parsedFile struct {
+	fset *token.FileSet
+	file *ast.File
+	src  []byte
+}
+
+var (
+	parsedMu    sync.Mutex
+	parsedFiles = map[string]*parsedFile{}
+)
+
+// parse returns the parsed source file at path, or nil if it can't be read
+// or parsed. Results are cached, since the same file is usually parsed once
+// for each failure in it.
+func parse(path string) *parsedFile {
+	parsedMu.Lock()
+	defer parsedMu.Unlock()
+	if p, ok := parsedFiles[path]; ok {
+		return p
+	}
+	var p *parsedFile
+	if src, err := os.ReadFile(path); err == nil {
+		fset := token.NewFileSet()
+		if file, err := parser.ParseFile(fset, path, src, 0); err == nil {
+			p = &parsedFile{fset: fset, file: file, src: src}
+		}
+	}
+	parsedFiles[path] = p
+	return p
+}
+
+// text returns the source code of node, as it was written.
+func (p *parsedFile) text(node ast.Node) string {
+	start := p.fset.Position(node.Pos()).Offset
+	end := p.fset.Position(node.End()).Offset
+	return string(p.src[start:end])
+}
+
+// source describes the expressions that f's caller passed to the check,
+// using the parameter names of the check's declaration at checkAt. It returns
+// "" if either source file isn't available.
+func source(f Failure, checkAt Caller) string {
+	_, name, _ := strings.Cut(f.Check, ".")
+	if name == "" || f.Caller.File == "" {
+		return ""
+	}
+	caller := parse(f.Caller.File)
+	if caller == nil {
+		return ""
+	}
+	call := findCall(caller, f.Caller.Line, name)
+	if call == nil {
+		return ""
+	}
+	var params []*ast.Field
+	if decl := parse(checkAt.File); decl != nil {
+		params = findParams(decl, name)
+	}
+
+	// Show the call without t, which is the same in every check, and label
+	// each other argument with the name of its parameter. If there are fewer
+	// arguments than parameters, t was passed implicitly, like in the methods
+	// of testy.New.
+	implicitT := len(call.Args) < requiredParams(params)
+	var args, labels []string
+	var exprs []string
+	i := 0
+	for _, field := range params {
+		if implicitT && isT(field.Type) {
+			continue
+		}
+		_, variadic := field.Type.(*ast.Ellipsis)
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{{Name: "_"}}
+		}
+		for _, param := range names {
+			if i >= len(call.Args) || variadic {
+				break
+			}
+			expr := oneLine(caller.text(call.Args[i]))
+			i++
+			if isT(field.Type) {
+				continue
+			}
+			args = append(args, expr)
+			if expr != param.Name {
+				labels = append(labels, param.Name)
+				exprs = append(exprs, expr)
+			}
+		}
+	}
+	if params == nil {
+		for _, arg := range call.Args[min(1, len(call.Args)):] {
+			args = append(args, oneLine(caller.text(arg)))
+		}
+	}
+
+	line := fmt.Sprintf("%s(%s)", oneLine(caller.text(call.Fun)), strings.Join(args, ", "))
+	if len(args) == 1 && f.Got != nil {
+		return fmt.Sprintf("source: %s → %s", line, compactValue(f.Got))
+	}
+	width := len("source")
+	for _, label := range labels {
+		width = max(width, len(label))
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%*s: %s", width, "source", line)
+	for i, label := range labels {
+		fmt.Fprintf(&b, "\n%*s: %s", width, label, exprs[i])
+	}
+	return b.String()
+}
+
+// findCall returns the innermost call to a function called name that spans
+// the given line.
+func findCall(p *parsedFile, line int, name string) *ast.CallExpr {
+	var found *ast.CallExpr
+	ast.Inspect(p.file, func(node ast.Node) bool {
+		if node == nil {
+			return false
+		}
+		if p.fset.Position(node.Pos()).Line > line || p.fset.Position(node.End()).Line < line {
+			return false
+		}
+		if call, ok := node.(*ast.CallExpr); ok && calledName(call.Fun) == name {
+			found = call
+		}
+		return true
+	})
+	return found
+}
+
+// calledName returns the name of the function called by fun, like "Equal"
+// for check.Equal or check.Equal[any].
+func calledName(fun ast.Expr) string {
+	switch fun := fun.(type) {
+	case *ast.Ident:
+		return fun.Name
+	case *ast.SelectorExpr:
+		return fun.Sel.Name
+	case *ast.IndexExpr:
+		return calledName(fun.X)
+	case *ast.IndexListExpr:
+		return calledName(fun.X)
+	default:
+		return ""
+	}
+}
+
+// findParams returns the parameters of the function called name declared in
+// p.
+func findParams(p *parsedFile, name string) []*ast.Field {
+	for _, decl := range p.file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == name {
+			return fn.Type.Params.List
+		}
+	}
+	return nil
+}
+
+// requiredParams returns the number of non-variadic parameters in params.
+func requiredParams(params []*ast.Field) int {
+	n := 0
+	for _, field := range params {
+		if _, variadic := field.Type.(*ast.Ellipsis); !variadic {
+			n += max(1, len(field.Names))
+		}
+	}
+	return n
+}
+
+// isT returns true if typ is common.T.
+func isT(typ ast.Expr) bool {
+	sel, ok := typ.(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == "T"
+}
+
+// oneLine shortens a multi-line expression, like a function literal, to its
+// first line.
+func oneLine(s string) string {
+	if first, _, multiline := strings.Cut(s, "\n"); multiline {
+		return strings.TrimSpace(first) + " ..."
+	}
+	return s
+}
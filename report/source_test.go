@@ -0,0 +1,95 @@
+package report_test
+
+import (
+	"errors"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/report"
+)
+
+type user struct {
+	Name  string
+	admin bool
+}
+
+func (u user) IsAdmin() bool {
+	return u.admin
+}
+
+// sources returns the Source of every failure reported by fn.
+func sources(fn func(t common.T)) []string {
+	mt := &common.MockT{}
+	r := &recorder{}
+	report.Use(mt, r)
+	fn(mt)
+	var result []string
+	for _, f := range r.failures {
+		result = append(result, f.Source)
+	}
+	return result
+}
+
+//nolint:paralleltest // enables source capture for every test
+func TestShowSource(t *testing.T) {
+	report.ShowSource(true)
+	defer report.ShowSource(false)
+	check.True(t, report.ShowingSource())
+
+	u := user{Name: "peter"}
+	expected := user{Name: "bob"}
+	check.Equal(t, []string{
+		"source: check.True(u.IsAdmin()) → false",
+		"source: check.Equal(expected.Name, u.Name)\n  want: expected.Name\n   got: u.Name",
+		"source: assert.Equal[any](1, 2)\n  want: 1\n   got: 2",
+		"source: check.ErrorIs(err, fs.ErrClosed)\ntarget: fs.ErrClosed",
+		"   source: check.Contains(\"admin\", strings.ToLower(u.Name))\nsubstring: \"admin\"\n        s: strings.ToLower(u.Name)",
+		"source: check.NotPanics(func() { ...) → \"boom\"",
+	}, sources(func(t common.T) {
+		check.True(t, u.IsAdmin())
+		check.Equal(t,
+			expected.Name,
+			u.Name,
+		)
+		assert.Equal[any](t, 1, 2)
+		err := errors.New("oops")
+		check.ErrorIs(t, err, fs.ErrClosed)
+		check.Contains(t, "admin", strings.ToLower(u.Name))
+		check.NotPanics(t, func() {
+			panic("boom")
+		})
+	}))
+
+	// The source is also added to the message.
+	mt := &common.MockT{}
+	check.False(mt, true)
+	check.Equal(t, []string{"expected false\nsource: check.False(true) → true"}, mt.Errors())
+}
+
+func TestShowSourceDisabled(t *testing.T) {
+	t.Parallel()
+	check.Equal(t, []string{""}, sources(func(t common.T) {
+		check.True(t, false)
+	}))
+}
+
+//nolint:paralleltest // enables source capture for every test
+func TestShowSourceUnavailable(t *testing.T) {
+	report.ShowSource(true)
+	defer report.ShowSource(false)
+	mt := &common.MockT{}
+	r := &recorder{}
+	report.Use(mt, r)
+	report.Report(mt, report.Failure{
+		Check:   "check.True",
+		Message: "expected true",
+		Caller:  report.Caller{File: "/does/not/exist_test.go", Line: 1},
+	})
+	assert.Equal(t, 1, len(r.failures))
+	check.Equal(t, "", r.failures[0].Source)
+	check.Equal(t, "expected true", r.failures[0].Message)
+}
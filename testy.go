@@ -0,0 +1,81 @@
+// Package testy binds a test to handles for checking and asserting, so that
+// you don't have to pass t to every check:
+//
+//	func TestUser(t *testing.T) {
+//		tt := testy.New(t)
+//		user, err := LoadUser(1)
+//		tt.Assert.NoError(err)
+//		tt.Check.True(user.Active)
+//		testy.Equal(tt.Check, "peter", user.Name)
+//	}
+//
+// [Check] and [Assert] have a method for every non-generic function in the
+// check and assert packages. Go doesn't allow generic methods, so the generic
+// functions, like Equal, are functions in this package that take either
+// handle as their first argument and keep the same type safety.
+package testy
+
+import (
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+)
+
+// Testy bundles a test with handles for checking and asserting in it.
+type Testy struct {
+	// T is the test that the handles report failures to.
+	T common.T
+	// Check fails the test and continues running it, like the functions in
+	// the check package.
+	Check Check
+	// Assert fails the test and stops it, like the functions in the assert
+	// package.
+	Assert Assert
+}
+
+// New returns the handles for checking and asserting in t.
+func New(t common.T) Testy {
+	return Testy{T: t, Check: Check{t: t}, Assert: Assert{t: t}}
+}
+
+// With returns handles that add an annotation, formatted with fmt.Sprintf, as
+// a prefix to every failure reported through them. See [check.With].
+func (tt Testy) With(format string, args ...any) Testy {
+	return New(check.With(tt.T, format, args...))
+}
+
+// Handle is implemented by [Check] and [Assert], and is passed to the generic
+// functions in this package to choose between checking and asserting.
+type Handle interface {
+	// T returns the test that the handle reports failures to.
+	T() common.T
+	// Fatal returns true if a failure stops the test.
+	Fatal() bool
+}
+
+// Check has a method for every non-generic function in the check package,
+// which fail the test and continue running it.
+type Check struct {
+	t common.T
+}
+
+func (c Check) T() common.T {
+	return c.t
+}
+
+func (Check) Fatal() bool {
+	return false
+}
+
+// Assert has a method for every non-generic function in the assert package,
+// which fail the test and stop it.
+type Assert struct {
+	t common.T
+}
+
+func (a Assert) T() common.T {
+	return a.t
+}
+
+func (Assert) Fatal() bool {
+	return true
+}
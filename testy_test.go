@@ -0,0 +1,213 @@
+package testy_test
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/peterldowns/testy"
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/report"
+)
+
+// recorder is a Reporter that remembers every failure.
+type recorder struct {
+	failures []report.Failure
+}
+
+func (r *recorder) Report(t common.T, f report.Failure) {
+	t.Helper()
+	r.failures = append(r.failures, f)
+	t.Fail()
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+	tt := testy.New(t)
+	tt.Check.True(true)
+	tt.Check.NoError(nil)
+	tt.Check.Empty("")
+	testy.Equal(tt.Check, "peter", "peter")
+	testy.Contains(tt.Check, "ete", "peter")
+	testy.InDelta(tt.Check, 1.0, 1.05, 0.1)
+
+	mt := &common.MockT{}
+	r := &recorder{}
+	report.Use(mt, r)
+	mtt := testy.New(mt)
+	check.False(t, mtt.Check.True(false))
+	check.False(t, mtt.Check.ErrorIs(errors.New("oops"), fs.ErrNotExist))
+	check.False(t, testy.Equal(mtt.Check, 1, 2))
+	check.False(t, testy.LessThan(mtt.Check, 2, 1))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+
+	var names []string
+	for _, f := range r.failures {
+		check.False(t, f.Fatal)
+		check.HasSuffix(t, "testy_test.go", f.Caller.File)
+		names = append(names, f.Check)
+	}
+	check.Equal(t, []string{"check.True", "check.ErrorIs", "check.Equal", "check.LessThan"}, names)
+}
+
+func TestAssert(t *testing.T) {
+	t.Parallel()
+	tt := testy.New(t)
+	tt.Assert.True(true)
+	tt.Assert.NoFailures()
+	check.True(t, testy.Equal(tt.Assert, 1, 1))
+	check.True(t, tt.Assert.Fatal())
+	check.False(t, tt.Check.Fatal())
+
+	mt := &common.MockT{}
+	r := &recorder{}
+	report.Use(mt, r)
+	testy.New(mt).Assert.NoError(errors.New("oops"))
+	check.True(t, mt.FailedNow())
+	assert.Equal(t, 1, len(r.failures))
+	check.Equal(t, "assert.NoError", r.failures[0].Check)
+	check.True(t, r.failures[0].Fatal)
+
+	mt = &common.MockT{}
+	r = &recorder{}
+	report.Use(mt, r)
+	testy.HasPrefix(testy.New(mt).Assert, "/usr", "/var")
+	check.True(t, mt.FailedNow())
+	assert.Equal(t, 1, len(r.failures))
+	check.Equal(t, "assert.HasPrefix", r.failures[0].Check)
+	check.HasSuffix(t, "testy_test.go", r.failures[0].Caller.File)
+}
+
+type codeError struct{ code int }
+
+func (e codeError) Error() string { return "code error" }
+
+func TestErrorAs(t *testing.T) {
+	t.Parallel()
+	tt := testy.New(t)
+	target, ok := testy.ErrorAs[codeError](tt.Check, codeError{code: 3})
+	check.True(t, ok)
+	check.Equal(t, 3, target.code)
+	target, ok = testy.ErrorAs[codeError](tt.Assert, codeError{code: 4})
+	check.True(t, ok)
+	check.Equal(t, 4, target.code)
+
+	mt := &common.MockT{}
+	_, ok = testy.ErrorAs[codeError](testy.New(mt).Check, errors.New("oops"))
+	check.False(t, ok)
+	check.True(t, mt.Failed())
+}
+
+func TestDefine(t *testing.T) {
+	t.Parallel()
+	isEven := testy.Define(func(n int) (bool, string) {
+		return n%2 == 0, "expected an even number"
+	})
+	tt := testy.New(t)
+	check.True(t, isEven(tt.Check, 2))
+	check.True(t, isEven(tt.Assert, 4))
+
+	mt := &common.MockT{}
+	check.False(t, isEven(testy.New(mt).Check, 3))
+	check.True(t, mt.Failed())
+	check.False(t, mt.FailedNow())
+
+	mt = &common.MockT{}
+	isEven(testy.New(mt).Assert, 3)
+	check.True(t, mt.FailedNow())
+}
+
+func TestWith(t *testing.T) {
+	t.Parallel()
+	mt := &common.MockT{}
+	tt := testy.New(mt).With("user %d", 1).With("order %d", 2)
+	tt.Check.True(false)
+	testy.Equal(tt.Check, "a", "b")
+	check.Equal(t, []string{
+		"user 1: order 2: expected true",
+		"user 1: order 2: expected want == got\nwant: \"a\"\n got: \"b\"\n       ^",
+	}, mt.Errors())
+}
+
+// TestMirrorsEveryFunction makes sure that every function in check and assert
+// that takes a common.T is either a method of the matching handle, or a
+// generic function in this package.
+func TestMirrorsEveryFunction(t *testing.T) {
+	t.Parallel()
+	generic := exportedFuncs(t, ".")
+	for pkg, handle := range map[string]reflect.Type{
+		"check":  reflect.TypeOf(testy.Check{}),
+		"assert": reflect.TypeOf(testy.Assert{}),
+	} {
+		for name, fn := range exportedFuncs(t, pkg) {
+			if !takesT(fn) || name == "With" {
+				continue
+			}
+			t := check.With(t, "%s.%s", pkg, name)
+			if fn.Type.TypeParams != nil {
+				check.True(t, generic[name] != nil && generic[name].Type.TypeParams != nil)
+			} else {
+				_, ok := handle.MethodByName(name)
+				check.True(t, ok)
+			}
+		}
+	}
+}
+
+func exportedFuncs(t *testing.T, dir string) map[string]*ast.FuncDecl {
+	t.Helper()
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	assert.NoError(t, err)
+	funcs := map[string]*ast.FuncDecl{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.IsExported() {
+					funcs[fn.Name.Name] = fn
+				}
+			}
+		}
+	}
+	return funcs
+}
+
+func takesT(fn *ast.FuncDecl) bool {
+	params := fn.Type.Params.List
+	if len(params) == 0 {
+		return false
+	}
+	sel, ok := params[0].Type.(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == "T"
+}
+
+//nolint:paralleltest // enables source capture for every test
+func TestShowSource(t *testing.T) {
+	report.ShowSource(true)
+	defer report.ShowSource(false)
+
+	mt := &common.MockT{}
+	r := &recorder{}
+	report.Use(mt, r)
+	tt := testy.New(mt)
+	want, got := 1, 2
+	tt.Check.True(want == got)
+	testy.Equal(tt.Check, want, got)
+	var sources []string
+	for _, f := range r.failures {
+		sources = append(sources, f.Source)
+	}
+	check.Equal(t, []string{
+		"source: tt.Check.True(want == got) → false",
+		"source: testy.Equal(want, got)",
+	}, sources)
+}
@@ -0,0 +1,121 @@
+// Package testytest helps you test your own check and assert helpers. Each
+// function runs a test body against a [common.MockT], like a subtest, and
+// checks how it failed:
+//
+//	func TestIsAdult(t *testing.T) {
+//		testytest.ExpectPass(t, func(t common.T) { IsAdult(t, User{Age: 21}) })
+//		testytest.ExpectFail(t, func(t common.T) { IsAdult(t, User{Age: 17}) })
+//		testytest.ExpectMessage(t, "got age 17", func(t common.T) { IsAdult(t, User{Age: 17}) })
+//	}
+//
+// The body runs on its own goroutine, so a call to t.FailNow() stops it like it
+// would stop a real test. Failures in the body are always reported to the
+// MockT with [report.Plain], so the messages don't depend on TESTY_OUTPUT.
+//
+// Like the functions in check, these return true if they passed. Otherwise,
+// the test is marked as failed with t.Error() and continues running.
+package testytest
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/report"
+)
+
+// ExpectPass passes if fn does not fail t.
+func ExpectPass(t common.T, fn func(t common.T)) bool {
+	t.Helper()
+	mt := run(fn)
+	if !mt.Failed() {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: "expected fn to pass" + describe(mt),
+	})
+	return false
+}
+
+// ExpectFail passes if fn marks t as failed and keeps running, like a check
+// does when it fails.
+func ExpectFail(t common.T, fn func(t common.T)) bool {
+	t.Helper()
+	mt := run(fn)
+	if mt.Failed() && !mt.FailedNow() {
+		return true
+	}
+	message := "expected fn to fail"
+	if mt.FailedNow() {
+		message = "expected fn to fail without calling FailNow"
+	}
+	report.Report(t, report.Failure{
+		Message: message + describe(mt),
+	})
+	return false
+}
+
+// ExpectFailNow passes if fn fails t and stops with t.FailNow(), like an
+// assert does when it fails.
+func ExpectFailNow(t common.T, fn func(t common.T)) bool {
+	t.Helper()
+	mt := run(fn)
+	if mt.FailedNow() {
+		return true
+	}
+	report.Report(t, report.Failure{
+		Message: "expected fn to call FailNow" + describe(mt),
+	})
+	return false
+}
+
+// ExpectMessage passes if fn fails t with a message that contains substring.
+func ExpectMessage(t common.T, substring string, fn func(t common.T)) bool {
+	t.Helper()
+	mt := run(fn)
+	for _, m := range mt.Messages() {
+		if m.Error && strings.Contains(m.Text, substring) {
+			return true
+		}
+	}
+	report.Report(t, report.Failure{
+		Message: "expected fn to fail with a message containing substring" + describe(mt),
+		Want:    substring,
+	})
+	return false
+}
+
+// run calls fn with a new MockT and waits for it to finish.
+func run(fn func(t common.T)) *common.MockT {
+	mt := &common.MockT{}
+	report.Use(mt, report.Plain{}) // removed by mt's cleanup when Run returns
+	mt.Run(func(mt *common.MockT) {
+		fn(mt)
+	})
+	return mt
+}
+
+// describe summarizes how mt failed and the messages it logged.
+func describe(mt *common.MockT) string {
+	var b strings.Builder
+	switch {
+	case mt.FailedNow():
+		b.WriteString("\nfn failed and called FailNow")
+	case mt.Failed():
+		b.WriteString("\nfn failed")
+	default:
+		b.WriteString("\nfn passed")
+	}
+	messages := mt.Messages()
+	if len(messages) == 0 {
+		b.WriteString(" without any messages")
+		return b.String()
+	}
+	b.WriteString(" with messages:")
+	for _, m := range messages {
+		text := strings.ReplaceAll(m.Text, "\n", "\n    ")
+		fmt.Fprintf(&b, "\n  %s:%d: %s", filepath.Base(m.File), m.Line, text)
+	}
+	return b.String()
+}
@@ -0,0 +1,69 @@
+package testytest_test
+
+import (
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/peterldowns/testy/assert"
+	"github.com/peterldowns/testy/check"
+	"github.com/peterldowns/testy/common"
+	"github.com/peterldowns/testy/testytest"
+)
+
+func TestExpectPass(t *testing.T) {
+	t.Parallel()
+	check.True(t, testytest.ExpectPass(t, func(t common.T) {
+		check.True(t, true)
+	}))
+	testytest.ExpectFail(t, func(t common.T) {
+		testytest.ExpectPass(t, func(t common.T) {
+			check.True(t, false)
+		})
+	})
+}
+
+func TestExpectFail(t *testing.T) {
+	t.Parallel()
+	check.True(t, testytest.ExpectFail(t, func(t common.T) {
+		check.True(t, false)
+	}))
+	testytest.ExpectMessage(t, "expected fn to fail\nfn passed without any messages", func(t common.T) {
+		testytest.ExpectFail(t, func(t common.T) {})
+	})
+	testytest.ExpectMessage(t, "expected fn to fail without calling FailNow", func(t common.T) {
+		testytest.ExpectFail(t, func(t common.T) {
+			assert.True(t, false)
+		})
+	})
+}
+
+func TestExpectFailNow(t *testing.T) {
+	t.Parallel()
+	reached := false
+	check.True(t, testytest.ExpectFailNow(t, func(t common.T) {
+		assert.True(t, false)
+		reached = true
+	}))
+	check.False(t, reached)
+	testytest.ExpectMessage(t, "expected fn to call FailNow\nfn failed with messages:", func(t common.T) {
+		testytest.ExpectFailNow(t, func(t common.T) {
+			check.True(t, false)
+		})
+	})
+}
+
+func TestExpectMessage(t *testing.T) {
+	t.Parallel()
+	check.True(t, testytest.ExpectMessage(t, "want: \"a\"", func(t common.T) {
+		check.Equal(t, "a", "b")
+	}))
+
+	// A failure shows the messages that fn did log, and where.
+	_, _, line, _ := runtime.Caller(0)
+	testytest.ExpectMessage(t, "testytest_test.go:"+strconv.Itoa(line+3)+": expected true", func(t common.T) {
+		testytest.ExpectMessage(t, "expected false", func(t common.T) {
+			check.True(t, false)
+		})
+	})
+}